@@ -0,0 +1,4 @@
+// Package imaging declares the types of the ONVIF Imaging service, generated from its WSDL.
+package imaging
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../docs/wsdl/imaging.wsdl -types -values SetImagingSettings.ForcePersistence -o types_auto.go imaging
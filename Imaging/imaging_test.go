@@ -0,0 +1,70 @@
+package imaging
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMoveMarshal(t *testing.T) {
+	for _, c := range []struct {
+		focus onvif.FocusMove
+		want  string
+	}{
+		{onvif.FocusMove{}, `<timg:Focus><onvif:Absolute><onvif:Position>0</onvif:Position><onvif:Speed>0</onvif:Speed></onvif:Absolute></timg:Focus>`},
+		{onvif.FocusMove{Absolute: onvif.AbsoluteFocus{Position: 0.5}}, `<timg:Focus><onvif:Absolute><onvif:Position>0.5</onvif:Position><onvif:Speed>0</onvif:Speed></onvif:Absolute></timg:Focus>`},
+		{onvif.FocusMove{Relative: onvif.RelativeFocus{Distance: -1}}, `<timg:Focus><onvif:Relative><onvif:Distance>-1</onvif:Distance><onvif:Speed>0</onvif:Speed></onvif:Relative></timg:Focus>`},
+		{onvif.FocusMove{Continuous: onvif.ContinuousFocus{Speed: 1}}, `<timg:Focus><onvif:Continuous><onvif:Speed>1</onvif:Speed></onvif:Continuous></timg:Focus>`},
+	} {
+		data, err := xml.Marshal(Move{VideoSourceToken: "source", Focus: c.focus})
+		require.NoError(t, err)
+		assert.Equal(t, `<timg:Move><timg:VideoSourceToken>source</timg:VideoSourceToken>`+c.want+`</timg:Move>`, string(data))
+	}
+}
+
+func TestSetImagingSettingsMarshal(t *testing.T) {
+	// ForcePersistence is always sent, false included
+	data, err := xml.Marshal(SetImagingSettings{VideoSourceToken: "source"})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<timg:ForcePersistence>false</timg:ForcePersistence>`)
+}
+
+func TestGetImagingSettingsResponseUnmarshal(t *testing.T) {
+	const reply = `<timg:GetImagingSettingsResponse xmlns:timg="http://www.onvif.org/ver20/imaging/wsdl" xmlns:tt="http://www.onvif.org/ver10/schema">
+		<timg:ImagingSettings>
+			<tt:BacklightCompensation><tt:Mode>OFF</tt:Mode></tt:BacklightCompensation>
+			<tt:Brightness>50</tt:Brightness>
+			<tt:Exposure><tt:Mode>AUTO</tt:Mode><tt:MaxGain>30</tt:MaxGain></tt:Exposure>
+			<tt:Focus><tt:AutoFocusMode>MANUAL</tt:AutoFocusMode><tt:DefaultSpeed>1</tt:DefaultSpeed></tt:Focus>
+			<tt:IrCutFilter>AUTO</tt:IrCutFilter>
+			<tt:WhiteBalance><tt:Mode>AUTO</tt:Mode></tt:WhiteBalance>
+			<tt:Extension><tt:ImageStabilization><tt:Mode>ON</tt:Mode></tt:ImageStabilization>
+				<tt:Extension><tt:IrCutFilterAutoAdjustment><tt:BoundaryType>Common</tt:BoundaryType></tt:IrCutFilterAutoAdjustment>
+					<tt:Extension><tt:Defogging><tt:Mode>AUTO</tt:Mode><tt:Level>0.5</tt:Level></tt:Defogging></tt:Extension>
+				</tt:Extension>
+			</tt:Extension>
+		</timg:ImagingSettings>
+	</timg:GetImagingSettingsResponse>`
+
+	var resp GetImagingSettingsResponse
+	require.NoError(t, xml.Unmarshal([]byte(reply), &resp))
+	settings := resp.ImagingSettings
+	assert.Equal(t, 50.0, settings.Brightness)
+	require.NotNil(t, settings.BacklightCompensation)
+	assert.Equal(t, onvif.BacklightCompensationMode("OFF"), settings.BacklightCompensation.Mode)
+	require.NotNil(t, settings.Exposure)
+	assert.Equal(t, onvif.ExposureMode("AUTO"), settings.Exposure.Mode)
+	assert.Equal(t, 30.0, settings.Exposure.MaxGain)
+	require.NotNil(t, settings.Focus)
+	assert.Equal(t, onvif.AutoFocusMode("MANUAL"), settings.Focus.AutoFocusMode)
+	require.NotNil(t, settings.IrCutFilter)
+	assert.Equal(t, onvif.IrCutFilterMode("AUTO"), *settings.IrCutFilter)
+	require.NotNil(t, settings.Extension)
+	assert.Equal(t, onvif.ImageStabilizationMode("ON"), settings.Extension.ImageStabilization.Mode)
+	assert.Equal(t, "Common", settings.Extension.Extension.IrCutFilterAutoAdjustment.BoundaryType)
+	assert.Equal(t, 0.5, settings.Extension.Extension.Extension.Defogging.Level)
+}
@@ -0,0 +1,4 @@
+// Package analytics declares the types of the ONVIF Analytics service, generated from its WSDL.
+package analytics
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../docs/wsdl/analytics.wsdl -types -o types_auto.go analytics
@@ -0,0 +1,210 @@
+// Code generated by sdk/codegen from analytics.wsdl : DO NOT EDIT.
+
+package analytics
+
+import (
+	"github.com/BalkarSandhu/go-onvif/xsd"
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+)
+
+// GetSupportedRules is the request of the GetSupportedRules operation. List all rules that are
+// supported by the given VideoAnalyticsConfiguration. The result of this method may depend on the
+// overall Video analytics configuration of the device, which is available via the current set of
+// profiles.
+type GetSupportedRules struct {
+	XMLName            string               `xml:"tan:GetSupportedRules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetSupportedRulesResponse is the reply to the GetSupportedRules operation.
+type GetSupportedRulesResponse struct {
+	SupportedRules onvif.SupportedRules `xml:"SupportedRules"`
+}
+
+// CreateRules is the request of the CreateRules operation. Add one or more rules to an existing
+// VideoAnalyticsConfiguration. The available supported types can be retrieved via , where the Name
+// of the supported rule correspond to the type of an rule instance. Pass unique module names which
+// can be later used as reference. The Parameters of the rules must match those of the corresponding
+// description. Although this method is mandatory a device implementation must not support adding
+// rules. Instead it can provide a fixed set of predefined configurations via the media service
+// function .
+type CreateRules struct {
+	XMLName            string               `xml:"tan:CreateRules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	Rule               []onvif.Config       `xml:"tan:Rule"`
+}
+
+// CreateRulesResponse is the reply to the CreateRules operation.
+type CreateRulesResponse struct {
+}
+
+// DeleteRules is the request of the DeleteRules operation. Remove one or more rules from a
+// VideoAnalyticsConfiguration.
+type DeleteRules struct {
+	XMLName            string               `xml:"tan:DeleteRules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	RuleName           []xsd.String         `xml:"tan:RuleName"`
+}
+
+// DeleteRulesResponse is the reply to the DeleteRules operation.
+type DeleteRulesResponse struct {
+}
+
+// GetRules is the request of the GetRules operation. List the currently assigned set of rules of a
+// VideoAnalyticsConfiguration.
+type GetRules struct {
+	XMLName            string               `xml:"tan:GetRules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetRulesResponse is the reply to the GetRules operation.
+type GetRulesResponse struct {
+	Rule []onvif.Config `xml:"Rule"`
+}
+
+// GetRuleOptions is the request of the GetRuleOptions operation. Return the options for the
+// supported rules that specify an Option attribute.
+type GetRuleOptions struct {
+	XMLName            string               `xml:"tan:GetRuleOptions"`
+	RuleType           xsd.QName            `xml:"tan:RuleType,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetRuleOptionsResponse is the reply to the GetRuleOptions operation.
+type GetRuleOptionsResponse struct {
+	RuleOptions []ConfigOptions `xml:"RuleOptions"`
+}
+
+// ModifyRules is the request of the ModifyRules operation. Modify one or more rules of a
+// VideoAnalyticsConfiguration. The rules are referenced by their names.
+type ModifyRules struct {
+	XMLName            string               `xml:"tan:ModifyRules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	Rule               []onvif.Config       `xml:"tan:Rule"`
+}
+
+// ModifyRulesResponse is the reply to the ModifyRules operation.
+type ModifyRulesResponse struct {
+}
+
+// GetServiceCapabilities is the request of the GetServiceCapabilities operation. Returns the
+// capabilities of the analytics service. The result is returned in a typed answer.
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tan:GetServiceCapabilities"`
+}
+
+// GetServiceCapabilitiesResponse is the reply to the GetServiceCapabilities operation.
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities `xml:"Capabilities"`
+}
+
+// GetSupportedAnalyticsModules is the request of the GetSupportedAnalyticsModules operation. List
+// all analytics modules that are supported by the given VideoAnalyticsConfiguration. The result of
+// this method may depend on the overall Video analytics configuration of the device, which is
+// available via the current set of profiles.
+type GetSupportedAnalyticsModules struct {
+	XMLName            string               `xml:"tan:GetSupportedAnalyticsModules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetSupportedAnalyticsModulesResponse is the reply to the GetSupportedAnalyticsModules operation.
+type GetSupportedAnalyticsModulesResponse struct {
+	SupportedAnalyticsModules onvif.SupportedAnalyticsModules `xml:"SupportedAnalyticsModules"`
+}
+
+// GetAnalyticsModuleOptions is the request of the GetAnalyticsModuleOptions operation. Return the
+// options for the supported analytics modules that specify an Option attribute.
+type GetAnalyticsModuleOptions struct {
+	XMLName            string               `xml:"tan:GetAnalyticsModuleOptions"`
+	Type               xsd.QName            `xml:"tan:Type"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetAnalyticsModuleOptionsResponse is the reply to the GetAnalyticsModuleOptions operation.
+type GetAnalyticsModuleOptionsResponse struct {
+	Options []AnalyticsModuleConfigOptions `xml:"Options"`
+}
+
+// CreateAnalyticsModules is the request of the CreateAnalyticsModules operation. Add one or more
+// analytics modules to an existing VideoAnalyticsConfiguration. The available supported types can
+// be retrieved via , where the Name of the supported AnalyticsModules correspond to the type of an
+// AnalyticsModule instance. Pass unique module names which can be later used as reference. The
+// Parameters of the analytics module must match those of the corresponding
+// AnalyticsModuleDescription. Although this method is mandatory a device implementation must not
+// support adding modules. Instead it can provide a fixed set of predefined configurations via the
+// media service function . The device shall ensure that a corresponding analytics engine starts
+// operation when a client subscribes directly or indirectly for events produced by the analytics or
+// rule engine or when a client requests the corresponding scene description stream. An analytics
+// module must be attached to a Video source using the media profiles before it can be used. In case
+// differing analytics configurations are attached to the same profile it is undefined which of the
+// analytics module configuration becomes active if no stream is activated or multiple streams with
+// different profiles are activated at the same time.
+type CreateAnalyticsModules struct {
+	XMLName            string               `xml:"tan:CreateAnalyticsModules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	AnalyticsModule    []onvif.Config       `xml:"tan:AnalyticsModule"`
+}
+
+// CreateAnalyticsModulesResponse is the reply to the CreateAnalyticsModules operation.
+type CreateAnalyticsModulesResponse struct {
+}
+
+// DeleteAnalyticsModules is the request of the DeleteAnalyticsModules operation. Remove one or more
+// analytics modules from a VideoAnalyticsConfiguration referenced by their names.
+type DeleteAnalyticsModules struct {
+	XMLName             string               `xml:"tan:DeleteAnalyticsModules"`
+	ConfigurationToken  onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	AnalyticsModuleName []xsd.String         `xml:"tan:AnalyticsModuleName"`
+}
+
+// DeleteAnalyticsModulesResponse is the reply to the DeleteAnalyticsModules operation.
+type DeleteAnalyticsModulesResponse struct {
+}
+
+// GetAnalyticsModules is the request of the GetAnalyticsModules operation. List the currently
+// assigned set of analytics modules of a VideoAnalyticsConfiguration.
+type GetAnalyticsModules struct {
+	XMLName            string               `xml:"tan:GetAnalyticsModules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+}
+
+// GetAnalyticsModulesResponse is the reply to the GetAnalyticsModules operation.
+type GetAnalyticsModulesResponse struct {
+	AnalyticsModule []onvif.Config `xml:"AnalyticsModule"`
+}
+
+// ModifyAnalyticsModules is the request of the ModifyAnalyticsModules operation. Modify the
+// settings of one or more analytics modules of a VideoAnalyticsConfiguration. The modules are
+// referenced by their names. It is allowed to pass only a subset to be modified.
+type ModifyAnalyticsModules struct {
+	XMLName            string               `xml:"tan:ModifyAnalyticsModules"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tan:ConfigurationToken"`
+	AnalyticsModule    []onvif.Config       `xml:"tan:AnalyticsModule"`
+}
+
+// ModifyAnalyticsModulesResponse is the reply to the ModifyAnalyticsModules operation.
+type ModifyAnalyticsModulesResponse struct {
+}
+
+// Capabilities type.
+type Capabilities struct {
+	RuleSupport                        xsd.Boolean `xml:"RuleSupport,attr"`
+	AnalyticsModuleSupport             xsd.Boolean `xml:"AnalyticsModuleSupport,attr"`
+	CellBasedSceneDescriptionSupported xsd.Boolean `xml:"CellBasedSceneDescriptionSupported,attr"`
+	RuleOptionsSupported               xsd.Boolean `xml:"RuleOptionsSupported,attr"`
+	AnalyticsModuleOptionsSupported    xsd.Boolean `xml:"AnalyticsModuleOptionsSupported,attr"`
+}
+
+// ConfigOptions type.
+type ConfigOptions struct {
+	RuleType xsd.QName  `xml:"RuleType,attr"`
+	Name     xsd.String `xml:"Name,attr"`
+	Type     xsd.QName  `xml:"Type,attr"`
+	Any      string     `xml:",innerxml"`
+}
+
+// AnalyticsModuleConfigOptions type.
+type AnalyticsModuleConfigOptions struct {
+	Type xsd.QName `xml:"Type,attr"`
+	Any  string    `xml:",innerxml"`
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"bytes"
+	"encoding/xml"
+
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+)
+
+// UnmarshalXML keeps the reference parameters as XML elements declaring
+// their namespaces, so that they can be copied into the header of the
+// requests sent to the endpoint, away from the declarations of the reply
+func (r *ReferenceParametersType) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var buf bytes.Buffer
+	e := xml.NewEncoder(&buf)
+	for depth := 0; ; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			t.Attr = onvif.WithoutNamespaceDeclarations(t.Attr)
+			tok = t
+		case xml.EndElement:
+			if depth == 0 {
+				if err := e.Flush(); err != nil {
+					return err
+				}
+				r.Any = buf.String()
+				return nil
+			}
+			depth--
+		case xml.ProcInst, xml.Directive:
+			continue
+		}
+		if err := e.EncodeToken(xml.CopyToken(tok)); err != nil {
+			return err
+		}
+	}
+}
@@ -65,7 +65,8 @@ type EndpointReference EndpointReferenceType
 
 // ReferenceParametersType in ws-addr
 type ReferenceParametersType struct { //wsa https://www.w3.org/2005/08/addressing/ws-addr.xsd
-	//Here can be anyAttribute
+	// Any holds the reference parameters, each element declaring its namespaces
+	Any string `xml:",innerxml"`
 }
 
 // Metadata in ws-addr
@@ -0,0 +1,103 @@
+package gosoap
+
+import (
+	"errors"
+
+	"github.com/beevik/etree"
+	"github.com/gofrs/uuid"
+)
+
+/*************************
+	WS-Addressing
+*************************/
+
+// AddressingNamespace is the namespace of WS-Addressing 1.0
+const AddressingNamespace = "http://www.w3.org/2005/08/addressing"
+
+// Action returns the action URI of the operation held by the Body, named
+// after the WSDL namespace and the name of the operation, e.g.
+// http://www.onvif.org/ver10/device/wsdl/GetDeviceInformation, unless the
+// WSDL names it otherwise. It is empty when the operation has no namespace.
+func (env *Envelope) Action() string {
+	return bodyAction(env.body)
+}
+
+// AddAction adds the WS-Addressing headers of a request to the Header: the
+// action of the operation held by the Body, a new message identifier and the
+// destination to
+func (env *Envelope) AddAction(to string) error {
+	action := env.Action()
+	if action == "" {
+		return errors.New("no action for the operation of the SOAP Body")
+	}
+	env.AddAddressing(action, to)
+	return nil
+}
+
+// AddAddressing adds the WS-Addressing headers of a request to the Header,
+// with the given action. The destination is omitted when to is empty.
+func (env *Envelope) AddAddressing(action, to string) {
+	addAddressing(env.root, env.header, action, to)
+}
+
+// AddReferenceParameters copies the reference parameters of the endpoint
+// reference the request is sent to into the Header. params holds their XML
+// elements, as the content of a wsa:ReferenceParameters element.
+func (env *Envelope) AddReferenceParameters(params string) error {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString("<ReferenceParameters>" + params + "</ReferenceParameters>"); err != nil {
+		return err
+	}
+	env.AddRootNamespace("wsa", AddressingNamespace)
+	for _, param := range doc.Root().ChildElements() {
+		param.CreateAttr("wsa:IsReferenceParameter", "true")
+		env.header.AddChild(param)
+	}
+	return nil
+}
+
+// AddAction adds the WS-Addressing headers of a request to the Header: the
+// action of the operation held by the Body and a new message identifier
+func (msg *SoapMessage) AddAction() error {
+	return msg.edit(func(doc *etree.Document) error {
+		header, err := soapElement(doc, "Header")
+		if err != nil {
+			return err
+		}
+		body, err := soapElement(doc, "Body")
+		if err != nil {
+			return err
+		}
+		action := bodyAction(body)
+		if action == "" {
+			return errors.New("no action for the operation of the SOAP Body")
+		}
+		addAddressing(doc.Root(), header, action, "")
+		return nil
+	})
+}
+
+func bodyAction(body *etree.Element) string {
+	children := body.ChildElements()
+	if len(children) == 0 {
+		return ""
+	}
+	ns := children[0].NamespaceURI()
+	if ns == "" {
+		return ""
+	}
+	action := ns + "/" + children[0].Tag
+	if wsdlAction, ok := wsdlActions[action]; ok {
+		return wsdlAction
+	}
+	return action
+}
+
+func addAddressing(root, header *etree.Element, action, to string) {
+	root.CreateAttr("xmlns:wsa", AddressingNamespace)
+	header.CreateElement("wsa:Action").SetText(action)
+	header.CreateElement("wsa:MessageID").SetText("urn:uuid:" + uuid.Must(uuid.NewV4()).String())
+	if to != "" {
+		header.CreateElement("wsa:To").SetText(to)
+	}
+}
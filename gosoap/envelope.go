@@ -0,0 +1,120 @@
+package gosoap
+
+import (
+	"encoding/xml"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// Envelope builds a SOAP message on a single document, serialized once by
+// String. Unlike the SoapMessage mutators, its methods do not parse the
+// message again.
+type Envelope struct {
+	doc    *etree.Document
+	root   *etree.Element
+	header *etree.Element
+	body   *etree.Element
+}
+
+// NewEnvelope returns an empty SOAP 1.2 envelope
+func NewEnvelope() *Envelope {
+	return newEnvelope(buildSoapRoot())
+}
+
+// NewEnvelope11 returns an empty SOAP 1.1 envelope, for the legacy devices
+// that do not understand SOAP 1.2
+func NewEnvelope11() *Envelope {
+	return newEnvelope(buildSoapRootNS(SOAP11EnvelopeNamespace, "http://schemas.xmlsoap.org/soap/encoding/"))
+}
+
+func newEnvelope(doc *etree.Document) *Envelope {
+	root := doc.Root()
+	return &Envelope{
+		doc:    doc,
+		root:   root,
+		header: root.SelectElement("Header"),
+		body:   root.SelectElement("Body"),
+	}
+}
+
+// AddRootNamespace declares a namespace prefix on the Envelope element
+func (env *Envelope) AddRootNamespace(key, value string) {
+	env.root.CreateAttr("xmlns:"+key, value)
+}
+
+// AddRootNamespaces declares the namespace prefixes on the Envelope element,
+// in the order of the prefixes
+func (env *Envelope) AddRootNamespaces(namespaces map[string]string) {
+	keys := make([]string, 0, len(namespaces))
+	for key := range namespaces {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		env.AddRootNamespace(key, namespaces[key])
+	}
+}
+
+// AddBodyContent appends element to the Body
+func (env *Envelope) AddBodyContent(element *etree.Element) {
+	env.body.AddChild(element)
+}
+
+// AddStringBodyContent parses data and appends its root element to the Body
+func (env *Envelope) AddStringBodyContent(data string) error {
+	element, err := parseElement(data)
+	if err != nil {
+		return err
+	}
+	env.body.AddChild(element)
+	return nil
+}
+
+// AddHeaderContent appends element to the Header
+func (env *Envelope) AddHeaderContent(element *etree.Element) {
+	env.header.AddChild(element)
+}
+
+// AddStringHeaderContent parses data and appends its root element to the Header
+func (env *Envelope) AddStringHeaderContent(data string) error {
+	element, err := parseElement(data)
+	if err != nil {
+		return err
+	}
+	env.header.AddChild(element)
+	return nil
+}
+
+// AddWSSecurityAt adds a WS-UsernameToken created at the given time to the Header
+func (env *Envelope) AddWSSecurityAt(username, password string, created time.Time) error {
+	token, err := xml.MarshalIndent(NewSecurityAt(username, password, created), "", "  ")
+	if err != nil {
+		return err
+	}
+	return env.AddStringHeaderContent(string(token))
+}
+
+// String serializes the envelope
+func (env *Envelope) String() (string, error) {
+	return env.doc.WriteToString()
+}
+
+// SoapMessage serializes the envelope as a SoapMessage
+func (env *Envelope) SoapMessage() (SoapMessage, error) {
+	res, err := env.String()
+	return SoapMessage(res), err
+}
+
+func parseElement(data string) (*etree.Element, error) {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(data); err != nil {
+		return nil, err
+	}
+	if doc.Root() == nil {
+		return nil, errors.New("no XML element in the content")
+	}
+	return doc.Root(), nil
+}
@@ -0,0 +1,75 @@
+package gosoap
+
+import (
+	"testing"
+	"time"
+)
+
+// benchmarkNamespaces are the namespaces declared on the envelopes sent to the devices
+var benchmarkNamespaces = map[string]string{
+	"onvif": "http://www.onvif.org/ver10/schema",
+	"tt":    "http://www.onvif.org/ver10/schema",
+	"tds":   "http://www.onvif.org/ver10/device/wsdl",
+	"trt":   "http://www.onvif.org/ver10/media/wsdl",
+	"tr2":   "http://www.onvif.org/ver20/media/wsdl",
+	"tev":   "http://www.onvif.org/ver10/events/wsdl",
+	"tptz":  "http://www.onvif.org/ver20/ptz/wsdl",
+	"timg":  "http://www.onvif.org/ver20/imaging/wsdl",
+	"tan":   "http://www.onvif.org/ver20/analytics/wsdl",
+	"trc":   "http://www.onvif.org/ver10/recording/wsdl",
+	"xmime": "http://www.w3.org/2005/05/xmlmime",
+	"wsnt":  "http://docs.oasis-open.org/wsn/b-2",
+	"xop":   "http://www.w3.org/2004/08/xop/include",
+	"wsa":   "http://www.w3.org/2005/08/addressing",
+}
+
+// benchmarkBody is a typical operation, as marshaled by the onvif package
+const benchmarkBody = `  <trt:GetStreamUri>
+      <trt:StreamSetup>
+          <onvif:Stream>RTP-Unicast</onvif:Stream>
+          <onvif:Transport>
+              <onvif:Protocol>RTSP</onvif:Protocol>
+          </onvif:Transport>
+      </trt:StreamSetup>
+      <trt:ProfileToken>Profile_1</trt:ProfileToken>
+  </trt:GetStreamUri>`
+
+func BenchmarkSoapMessage(b *testing.B) {
+	b.ReportAllocs()
+	created := time.Now()
+	for i := 0; i < b.N; i++ {
+		msg := NewEmptySOAP()
+		if err := msg.AddStringBodyContentErr(benchmarkBody); err != nil {
+			b.Fatal(err)
+		}
+		if err := msg.AddRootNamespacesErr(benchmarkNamespaces); err != nil {
+			b.Fatal(err)
+		}
+		if err := msg.AddAction(); err != nil {
+			b.Fatal(err)
+		}
+		if err := msg.AddWSSecurityAt("admin", "secret", created); err != nil {
+			b.Fatal(err)
+		}
+		_ = msg.String()
+	}
+}
+
+func BenchmarkEnvelope(b *testing.B) {
+	b.ReportAllocs()
+	created := time.Now()
+	for i := 0; i < b.N; i++ {
+		env := NewEnvelope()
+		if err := env.AddStringBodyContent(benchmarkBody); err != nil {
+			b.Fatal(err)
+		}
+		env.AddRootNamespaces(benchmarkNamespaces)
+		env.AddAddressing(env.Action(), "http://192.168.0.10/onvif/media_service")
+		if err := env.AddWSSecurityAt("admin", "secret", created); err != nil {
+			b.Fatal(err)
+		}
+		if _, err := env.String(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,240 @@
+package gosoap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+/*************************
+	SOAP Fault types
+*************************/
+
+// SOAPFault is the error carried by the Body of a SOAP reply.
+// ONVIF devices report their errors as a generic Code (env:Sender, env:Receiver, ...)
+// refined by a chain of Subcodes (ter:NotAuthorized, ter:InvalidArgVal, ...).
+type SOAPFault struct {
+	// Code is the value of the fault code, e.g. "env:Sender"
+	Code string
+	// Subcodes is the chain of subcode values, outermost first, e.g. ["ter:InvalidArgVal", "ter:NoProfile"]
+	Subcodes []string
+	// Reason is the human readable explanation of the fault
+	Reason string
+	// Node and Role identify the SOAP node that raised the fault, when advertised
+	Node string
+	Role string
+	// Detail is the raw XML content of the Detail element
+	Detail string
+	// StatusCode is the HTTP status of the reply carrying the fault
+	StatusCode int
+}
+
+func (f *SOAPFault) Error() string {
+	codes := append([]string{f.Code}, f.Subcodes...)
+	msg := "soap fault " + strings.Join(codes, "/")
+	if f.Reason != "" {
+		msg += ": " + f.Reason
+	}
+	return msg
+}
+
+// HasCode tells if the code or one of the subcodes of the fault has the given
+// local name. The namespace prefix is ignored on both sides, so that "ter:NotAuthorized"
+// and "NotAuthorized" are equivalent.
+func (f *SOAPFault) HasCode(name string) bool {
+	name = localName(name)
+	if localName(f.Code) == name {
+		return true
+	}
+	for _, c := range f.Subcodes {
+		if localName(c) == name {
+			return true
+		}
+	}
+	return false
+}
+
+type faultCode struct {
+	Value   string     `xml:"Value"`
+	Subcode *faultCode `xml:"Subcode"`
+}
+
+type faultText struct {
+	Lang  string `xml:"lang,attr"`
+	Value string `xml:",chardata"`
+}
+
+// faultBody holds the fields of both the SOAP 1.2 and the SOAP 1.1 faults
+type faultBody struct {
+	Code   faultCode `xml:"Code"`
+	Reason struct {
+		Text []faultText `xml:"Text"`
+	} `xml:"Reason"`
+	Node   string `xml:"Node"`
+	Role   string `xml:"Role"`
+	Detail struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"Detail"`
+
+	// SOAP 1.1
+	FaultCode   string `xml:"faultcode"`
+	FaultString string `xml:"faultstring"`
+	FaultActor  string `xml:"faultactor"`
+	Detail11    struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"detail"`
+}
+
+type faultEnvelope struct {
+	Body struct {
+		Fault *faultBody `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+// ParseFault extracts the SOAP Fault held by the Body of the envelope in data,
+// either SOAP 1.2 or SOAP 1.1. It returns nil if the envelope holds no fault.
+// The codes of a SOAP 1.1 fault have no subcodes (e.g. "s:Client"), their
+// faultactor is reported as the Role.
+func ParseFault(data []byte) (*SOAPFault, error) {
+	if !bytes.Contains(data, []byte("Fault")) {
+		return nil, nil
+	}
+
+	var env faultEnvelope
+	if err := xml.Unmarshal(data, &env); err != nil {
+		return nil, err
+	}
+	if env.Body.Fault == nil {
+		return nil, nil
+	}
+
+	return env.Body.Fault.toSOAPFault(), nil
+}
+
+// DecodeFault decodes the Fault element starting with start, either SOAP 1.2 or SOAP 1.1
+func DecodeFault(d *xml.Decoder, start *xml.StartElement) (*SOAPFault, error) {
+	var fb faultBody
+	if err := d.DecodeElement(&fb, start); err != nil {
+		return nil, err
+	}
+	return fb.toSOAPFault(), nil
+}
+
+func (fb *faultBody) toSOAPFault() *SOAPFault {
+	if fb.Code.Value == "" && fb.FaultCode != "" {
+		return &SOAPFault{
+			Code:   strings.TrimSpace(fb.FaultCode),
+			Reason: strings.TrimSpace(fb.FaultString),
+			Role:   strings.TrimSpace(fb.FaultActor),
+			Detail: strings.TrimSpace(fb.Detail11.Inner),
+		}
+	}
+
+	f := &SOAPFault{
+		Code:   strings.TrimSpace(fb.Code.Value),
+		Node:   strings.TrimSpace(fb.Node),
+		Role:   strings.TrimSpace(fb.Role),
+		Detail: strings.TrimSpace(fb.Detail.Inner),
+	}
+	for sub := fb.Code.Subcode; sub != nil; sub = sub.Subcode {
+		f.Subcodes = append(f.Subcodes, strings.TrimSpace(sub.Value))
+	}
+	for _, t := range fb.Reason.Text {
+		// Prefer the english text when several translations are provided
+		if f.Reason == "" || strings.HasPrefix(strings.ToLower(t.Lang), "en") {
+			f.Reason = strings.TrimSpace(t.Value)
+		}
+	}
+	return f
+}
+
+// FaultFromReply builds the error matching a reply with the given HTTP status
+// and body. It returns nil when the reply is a success. An authentication
+// rejection at the HTTP level (401, 403) without a SOAP fault in the body is
+// reported as a ter:NotAuthorized fault, as the ONVIF Core specification maps them.
+func FaultFromReply(statusCode int, data []byte) error {
+	fault, err := ParseFault(data)
+	if err == nil && fault != nil {
+		fault.StatusCode = statusCode
+		return fault
+	}
+
+	switch {
+	case statusCode >= 200 && statusCode < 300:
+		return nil
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		return &SOAPFault{
+			Code:       "env:Sender",
+			Subcodes:   []string{"ter:NotAuthorized"},
+			Reason:     http.StatusText(statusCode),
+			StatusCode: statusCode,
+		}
+	default:
+		return errors.New("unexpected HTTP status " + strconv.Itoa(statusCode) + " " + http.StatusText(statusCode))
+	}
+}
+
+// AsFault returns the SOAPFault wrapped in err, if any
+func AsFault(err error) (*SOAPFault, bool) {
+	var fault *SOAPFault
+	if errors.As(err, &fault) {
+		return fault, true
+	}
+	return nil, false
+}
+
+func hasFaultCode(err error, names ...string) bool {
+	fault, ok := AsFault(err)
+	if !ok {
+		return false
+	}
+	for _, name := range names {
+		if fault.HasCode(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotAuthorized tells if err is a fault reporting the request was rejected
+// because of the credentials: ter:NotAuthorized, or one of the WS-Security
+// faults (wsse:FailedAuthentication, wsse:InvalidSecurityToken, ...)
+func IsNotAuthorized(err error) bool {
+	return hasFaultCode(err, "NotAuthorized", "FailedAuthentication", "InvalidSecurity", "InvalidSecurityToken", "MessageExpired")
+}
+
+// IsVersionMismatch tells if err is a env:VersionMismatch fault, raised by the
+// devices not supporting the version of the SOAP envelope
+func IsVersionMismatch(err error) bool {
+	return hasFaultCode(err, "VersionMismatch")
+}
+
+// IsActionNotSupported tells if err is a ter:ActionNotSupported fault
+func IsActionNotSupported(err error) bool {
+	return hasFaultCode(err, "ActionNotSupported")
+}
+
+// IsInvalidArgVal tells if err is a ter:InvalidArgVal fault
+func IsInvalidArgVal(err error) bool {
+	return hasFaultCode(err, "InvalidArgVal")
+}
+
+// IsInvalidArgs tells if err is a ter:InvalidArgs fault
+func IsInvalidArgs(err error) bool {
+	return hasFaultCode(err, "InvalidArgs")
+}
+
+// IsOperationProhibited tells if err is a ter:OperationProhibited fault
+func IsOperationProhibited(err error) bool {
+	return hasFaultCode(err, "OperationProhibited")
+}
+
+func localName(qname string) string {
+	if i := strings.LastIndexByte(qname, ':'); i >= 0 {
+		return qname[i+1:]
+	}
+	return qname
+}
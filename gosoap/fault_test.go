@@ -0,0 +1,114 @@
+package gosoap
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const soap12Fault = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:ter="http://www.onvif.org/ver10/error">
+	<env:Body>
+		<env:Fault>
+			<env:Code>
+				<env:Value>env:Sender</env:Value>
+				<env:Subcode>
+					<env:Value>ter:InvalidArgVal</env:Value>
+					<env:Subcode><env:Value>ter:NoProfile</env:Value></env:Subcode>
+				</env:Subcode>
+			</env:Code>
+			<env:Reason>
+				<env:Text xml:lang="de">Profil unbekannt</env:Text>
+				<env:Text xml:lang="en">Unknown profile</env:Text>
+			</env:Reason>
+			<env:Detail><ter:Token>p1</ter:Token></env:Detail>
+		</env:Fault>
+	</env:Body>
+</env:Envelope>`
+
+const soap11Fault = `<?xml version="1.0" encoding="UTF-8"?>
+<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+	<s:Body>
+		<s:Fault>
+			<faultcode>s:Client</faultcode>
+			<faultstring>Action not supported</faultstring>
+			<faultactor>http://camera/onvif</faultactor>
+		</s:Fault>
+	</s:Body>
+</s:Envelope>`
+
+func TestParseFaultSOAP12(t *testing.T) {
+	fault, err := ParseFault([]byte(soap12Fault))
+	require.NoError(t, err)
+	require.NotNil(t, fault)
+
+	assert.Equal(t, "env:Sender", fault.Code)
+	assert.Equal(t, []string{"ter:InvalidArgVal", "ter:NoProfile"}, fault.Subcodes)
+	assert.Equal(t, "Unknown profile", fault.Reason)
+	assert.Contains(t, fault.Detail, "p1")
+	assert.True(t, fault.HasCode("NoProfile"))
+	assert.True(t, fault.HasCode("ter:InvalidArgVal"))
+	assert.False(t, fault.HasCode("NotAuthorized"))
+	assert.Equal(t, "soap fault env:Sender/ter:InvalidArgVal/ter:NoProfile: Unknown profile", fault.Error())
+}
+
+func TestParseFaultSOAP11(t *testing.T) {
+	fault, err := ParseFault([]byte(soap11Fault))
+	require.NoError(t, err)
+	require.NotNil(t, fault)
+
+	assert.Equal(t, "s:Client", fault.Code)
+	assert.Empty(t, fault.Subcodes)
+	assert.Equal(t, "Action not supported", fault.Reason)
+	assert.Equal(t, "http://camera/onvif", fault.Role)
+}
+
+func TestParseFaultWithoutFault(t *testing.T) {
+	fault, err := ParseFault([]byte(`<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"><env:Body/></env:Envelope>`))
+	assert.NoError(t, err)
+	assert.Nil(t, fault)
+}
+
+func TestFaultFromReply(t *testing.T) {
+	assert.NoError(t, FaultFromReply(http.StatusOK, nil))
+
+	err := FaultFromReply(http.StatusBadRequest, []byte(soap12Fault))
+	fault, ok := AsFault(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, fault.StatusCode)
+	assert.True(t, IsInvalidArgVal(err))
+
+	// A bare HTTP rejection is reported as ter:NotAuthorized
+	err = FaultFromReply(http.StatusUnauthorized, []byte("Unauthorized"))
+	assert.True(t, IsNotAuthorized(err))
+	fault, ok = AsFault(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, fault.StatusCode)
+
+	err = FaultFromReply(http.StatusBadGateway, nil)
+	require.Error(t, err)
+	_, ok = AsFault(err)
+	assert.False(t, ok)
+}
+
+func TestFaultPredicatesThroughAnnotations(t *testing.T) {
+	notAuthorized := &SOAPFault{Code: "env:Sender", Subcodes: []string{"ter:NotAuthorized"}}
+	actionNotSupported := &SOAPFault{Code: "env:Receiver", Subcodes: []string{"ter:ActionNotSupported"}}
+	expired := &SOAPFault{Code: "wsse:MessageExpired"}
+
+	assert.True(t, IsNotAuthorized(errors.Annotate(errors.Annotate(notAuthorized, "reply"), "call")))
+	assert.True(t, IsNotAuthorized(errors.Trace(expired)))
+	assert.False(t, IsNotAuthorized(errors.Annotate(actionNotSupported, "reply")))
+
+	assert.True(t, IsActionNotSupported(errors.Annotate(actionNotSupported, "reply")))
+	assert.False(t, IsActionNotSupported(errors.Annotate(notAuthorized, "reply")))
+	assert.False(t, IsActionNotSupported(errors.New("not a fault")))
+	assert.False(t, IsActionNotSupported(nil))
+
+	fault, ok := AsFault(errors.Annotate(actionNotSupported, "reply"))
+	require.True(t, ok)
+	assert.Same(t, actionNotSupported, fault)
+}
@@ -0,0 +1,384 @@
+package gosoap
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/gofrs/uuid"
+)
+
+/*************************
+	MTOM/XOP attachments
+*************************/
+
+// XOPData is implemented by the elements whose binary content may travel as
+// an MTOM attachment, referenced by a xop:Include (e.g. onvif.AttachmentData)
+type XOPData interface {
+	// XOPHref returns the cid: URI of the attachment, empty when the content is inline
+	XOPHref() string
+	SetXOPHref(href string)
+	// XOPContent returns the content type and the content of the element, if any
+	XOPContent() (contentType string, content io.Reader)
+	SetXOPContent(content io.Reader)
+}
+
+// Attachment is a binary part of an MTOM message
+type Attachment struct {
+	// ContentID is the identifier of the part, without the "cid:" prefix and the angle brackets
+	ContentID   string
+	ContentType string
+	Content     io.Reader
+}
+
+// IsMTOM tells if contentType is the one of an MTOM message
+func IsMTOM(contentType string) bool {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	return err == nil && mediaType == "multipart/related" && strings.Contains(params["type"], "application/xop+xml")
+}
+
+// CollectAttachments gives a cid: URI to each XOPData with a content held by v,
+// so that they are marshaled as a xop:Include, and returns their contents.
+// v must be a pointer.
+func CollectAttachments(v interface{}) []Attachment {
+	var attachments []Attachment
+	walkXOPData(reflect.ValueOf(v), func(data XOPData) {
+		contentType, content := data.XOPContent()
+		if content == nil {
+			return
+		}
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		id := uuid.Must(uuid.NewV4()).String() + "@go-onvif"
+		data.SetXOPHref("cid:" + id)
+		attachments = append(attachments, Attachment{ContentID: id, ContentType: contentType, Content: content})
+	})
+	return attachments
+}
+
+// BindAttachments sets the content of each XOPData held by v that references
+// an attachment of r, and returns their count. v must be a pointer.
+func BindAttachments(v interface{}, r *MTOMReader) int {
+	count := 0
+	walkXOPData(reflect.ValueOf(v), func(data XOPData) {
+		if href := data.XOPHref(); strings.HasPrefix(href, "cid:") {
+			data.SetXOPContent(r.Open(href))
+			count++
+		}
+	})
+	return count
+}
+
+var xopDataType = reflect.TypeOf((*XOPData)(nil)).Elem()
+
+func walkXOPData(v reflect.Value, visit func(XOPData)) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkXOPData(v.Elem(), visit)
+		}
+		return
+	case reflect.Struct:
+		if v.CanAddr() && v.Addr().Type().Implements(xopDataType) {
+			visit(v.Addr().Interface().(XOPData))
+			return
+		}
+		for i := 0; i < v.NumField(); i++ {
+			if v.Type().Field(i).IsExported() {
+				walkXOPData(v.Field(i), visit)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			walkXOPData(v.Index(i), visit)
+		}
+	}
+}
+
+// MTOMContentType returns the Content-Type of an MTOM message with the given boundary
+func MTOMContentType(boundary, soapContentType string) (string, error) {
+	mediaType, _, err := mime.ParseMediaType(soapContentType)
+	if err != nil {
+		return "", err
+	}
+	return mime.FormatMediaType("multipart/related", map[string]string{
+		"type":       "application/xop+xml",
+		"start":      "<root@go-onvif>",
+		"start-info": mediaType,
+		"boundary":   boundary,
+	}), nil
+}
+
+// NewMTOMBody returns a reader streaming the MTOM message, and its Content-Type
+func NewMTOMBody(envelope string, soapContentType string, attachments []Attachment) (io.Reader, string, error) {
+	pr, pw := io.Pipe()
+	mw := multipart.NewWriter(pw)
+	contentType, err := MTOMContentType(mw.Boundary(), soapContentType)
+	if err != nil {
+		return nil, "", err
+	}
+	go func() {
+		pw.CloseWithError(writeMTOM(mw, envelope, soapContentType, attachments))
+	}()
+	return pr, contentType, nil
+}
+
+func writeMTOM(mw *multipart.Writer, envelope string, soapContentType string, attachments []Attachment) error {
+	mediaType, params, err := mime.ParseMediaType(soapContentType)
+	if err != nil {
+		return err
+	}
+	params["type"] = mediaType
+	root := textproto.MIMEHeader{}
+	root.Set("Content-Type", mime.FormatMediaType("application/xop+xml", params))
+	root.Set("Content-Transfer-Encoding", "8bit")
+	root.Set("Content-ID", "<root@go-onvif>")
+	part, err := mw.CreatePart(root)
+	if err != nil {
+		return err
+	}
+	if _, err = io.WriteString(part, envelope); err != nil {
+		return err
+	}
+
+	for _, a := range attachments {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-Type", a.ContentType)
+		header.Set("Content-Transfer-Encoding", "binary")
+		header.Set("Content-ID", "<"+a.ContentID+">")
+		if part, err = mw.CreatePart(header); err != nil {
+			return err
+		}
+		if _, err = io.Copy(part, a.Content); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
+
+// MaxMTOMBufferSize bounds the parts of the MTOM messages read that are held
+// in memory: the root part, and the attachments skipped to reach another one
+var MaxMTOMBufferSize int64 = 16 << 20
+
+// ErrMTOMBufferFull is returned when the parts of an MTOM message held in
+// memory exceed the limit of its MTOMReader
+var ErrMTOMBufferFull = errors.New("parts of the MTOM message held in memory exceed the limit")
+
+// mtomPartOverhead is counted for each part held in memory, besides its
+// content, so that the limit also bounds the count of the empty parts
+const mtomPartOverhead = 512
+
+// MTOMReader reads an MTOM message: the envelope, then the attachments on demand.
+// The attachments are streamed when they are read in the order of the message,
+// the ones skipped to reach another one are held in memory.
+type MTOMReader struct {
+	// Envelope is the root part of the message
+	Envelope []byte
+
+	mu      sync.Mutex
+	body    io.ReadCloser
+	mr      *multipart.Reader
+	current *multipart.Part
+	skipped map[string][]byte
+	opened  int
+	done    bool
+	// held is what was held in memory, bounded by limit unless it is negative
+	held  int64
+	limit int64
+}
+
+// NewMTOMReader reads the envelope of the MTOM message in body, holding at
+// most MaxMTOMBufferSize bytes in memory. The body is closed once all the
+// attachments opened are read, or by Close.
+func NewMTOMReader(contentType string, body io.ReadCloser) (*MTOMReader, error) {
+	return NewMTOMReaderLimit(contentType, body, MaxMTOMBufferSize)
+}
+
+// NewMTOMReaderLimit reads the envelope of the MTOM message in body, as
+// NewMTOMReader does. The parts held in memory, the envelope included, are
+// bounded by limit bytes over the life of the reader, unbounded when negative.
+// Beyond it, the reading fails with ErrMTOMBufferFull.
+func NewMTOMReaderLimit(contentType string, body io.ReadCloser, limit int64) (*MTOMReader, error) {
+	_, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, err
+	}
+	if params["boundary"] == "" {
+		return nil, errors.New("MTOM message without boundary")
+	}
+
+	r := &MTOMReader{
+		body:    body,
+		mr:      multipart.NewReader(body, params["boundary"]),
+		skipped: make(map[string][]byte),
+		limit:   limit,
+	}
+
+	// The root part is the first one, unless the start parameter tells otherwise
+	start := strings.Trim(params["start"], "<>")
+	for {
+		part, err := r.mr.NextPart()
+		if err != nil {
+			return nil, err
+		}
+		data, err := r.hold(part)
+		if err != nil {
+			return nil, err
+		}
+		id := partID(part)
+		if start == "" || id == start {
+			r.Envelope = data
+			return r, nil
+		}
+		r.skipped[id] = data
+	}
+}
+
+// hold reads the rest of part into memory, within the limit of r
+func (r *MTOMReader) hold(part *multipart.Part) ([]byte, error) {
+	if r.limit < 0 {
+		return io.ReadAll(part)
+	}
+	if r.held += mtomPartOverhead; r.held > r.limit {
+		return nil, ErrMTOMBufferFull
+	}
+	data, err := io.ReadAll(io.LimitReader(part, r.limit-r.held+1))
+	if err != nil {
+		return nil, err
+	}
+	if r.held += int64(len(data)); r.held > r.limit {
+		return nil, ErrMTOMBufferFull
+	}
+	return data, nil
+}
+
+// Open returns the content of the attachment referenced by href (cid:...).
+// Closing it releases the whole message.
+func (r *MTOMReader) Open(href string) io.ReadCloser {
+	r.mu.Lock()
+	r.opened++
+	r.mu.Unlock()
+	return &attachmentReader{r: r, id: contentID(href)}
+}
+
+// Close releases the message, the attachments not read yet are lost
+func (r *MTOMReader) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.close()
+}
+
+func (r *MTOMReader) close() error {
+	if r.done {
+		return nil
+	}
+	r.done = true
+	return r.body.Close()
+}
+
+// seek makes the part with the given id the current one, holding in memory
+// the parts met before it
+func (r *MTOMReader) seek(id string) (io.Reader, error) {
+	if data, ok := r.skipped[id]; ok {
+		delete(r.skipped, id)
+		return bytes.NewReader(data), nil
+	}
+	for {
+		if r.current != nil {
+			// The previous part was not read completely
+			data, err := r.hold(r.current)
+			if err != nil {
+				return nil, err
+			}
+			r.skipped[partID(r.current)] = data
+			r.current = nil
+		}
+		if r.done {
+			return nil, errors.New("no attachment " + id)
+		}
+		part, err := r.mr.NextPart()
+		if err == io.EOF {
+			r.close()
+			return nil, errors.New("no attachment " + id)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if partID(part) == id {
+			r.current = part
+			return part, nil
+		}
+		r.current = part
+	}
+}
+
+type attachmentReader struct {
+	r      *MTOMReader
+	id     string
+	source io.Reader
+	err    error
+}
+
+func (a *attachmentReader) Read(p []byte) (int, error) {
+	a.r.mu.Lock()
+	defer a.r.mu.Unlock()
+	if a.err != nil {
+		return 0, a.err
+	}
+	if a.source == nil {
+		if a.source, a.err = a.r.seek(a.id); a.err != nil {
+			a.r.release()
+			return 0, a.err
+		}
+	}
+	if part, ok := a.source.(*multipart.Part); ok && part != a.r.current {
+		// Another attachment was read meanwhile, the rest of this one was kept
+		a.source = bytes.NewReader(a.r.skipped[a.id])
+		delete(a.r.skipped, a.id)
+	}
+	n, err := a.source.Read(p)
+	if err != nil {
+		a.err = err
+		if part, ok := a.source.(*multipart.Part); ok && part == a.r.current {
+			a.r.current = nil
+		}
+		a.r.release()
+	}
+	return n, err
+}
+
+// release closes the message once all the attachments opened are done
+func (r *MTOMReader) release() {
+	if r.opened--; r.opened == 0 {
+		r.close()
+	}
+}
+
+// Close releases the whole message
+func (a *attachmentReader) Close() error {
+	return a.r.Close()
+}
+
+func partID(part *multipart.Part) string {
+	return contentID(part.Header.Get("Content-ID"))
+}
+
+// contentID normalizes a Content-ID header or a cid: URI
+func contentID(id string) string {
+	id = strings.TrimSpace(id)
+	if strings.HasPrefix(strings.ToLower(id), "cid:") {
+		id = id[4:]
+		if unescaped, err := url.PathUnescape(id); err == nil {
+			id = unescaped
+		}
+	}
+	return strings.Trim(id, "<>")
+}
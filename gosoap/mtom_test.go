@@ -0,0 +1,111 @@
+package gosoap
+
+import (
+	"bytes"
+	"io"
+	"mime/multipart"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mtomPart is a part of a test MTOM message
+type mtomPart struct {
+	id      string
+	content string
+}
+
+// newMTOM returns an MTOM message made of parts, whose root is the one with the id root
+func newMTOM(t *testing.T, root string, parts ...mtomPart) (string, io.ReadCloser) {
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	for _, p := range parts {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-ID", "<"+p.id+">")
+		w, err := mw.CreatePart(header)
+		require.NoError(t, err)
+		io.WriteString(w, p.content)
+	}
+	require.NoError(t, mw.Close())
+	contentType := `multipart/related; type="application/xop+xml"; start="<` + root + `>"; boundary=` + mw.Boundary()
+	return contentType, io.NopCloser(&body)
+}
+
+func TestMTOMRoundTrip(t *testing.T) {
+	envelope := `<Envelope><Body><Upgrade><Firmware><Include href="cid:firmware"/></Firmware></Upgrade></Body></Envelope>`
+	body, contentType, err := NewMTOMBody(envelope, "application/soap+xml; charset=utf-8", []Attachment{
+		{ContentID: "firmware", ContentType: "application/octet-stream", Content: strings.NewReader("firmware")},
+		{ContentID: "notes", ContentType: "text/plain", Content: strings.NewReader("notes")},
+	})
+	require.NoError(t, err)
+	assert.True(t, IsMTOM(contentType))
+
+	r, err := NewMTOMReader(contentType, io.NopCloser(body))
+	require.NoError(t, err)
+	assert.Equal(t, envelope, string(r.Envelope))
+
+	// Read out of order, the firmware is held in memory meanwhile
+	notes, firmware := r.Open("cid:notes"), r.Open("cid:firmware")
+	data, err := io.ReadAll(notes)
+	require.NoError(t, err)
+	assert.Equal(t, "notes", string(data))
+	data, err = io.ReadAll(firmware)
+	require.NoError(t, err)
+	assert.Equal(t, "firmware", string(data))
+}
+
+func TestMTOMReaderLimit(t *testing.T) {
+	root := mtomPart{"root", strings.Repeat("e", 1000)}
+
+	contentType, body := newMTOM(t, "root", root)
+	_, err := NewMTOMReaderLimit(contentType, body, 1000)
+	assert.ErrorIs(t, err, ErrMTOMBufferFull)
+
+	contentType, body = newMTOM(t, "root", root)
+	r, err := NewMTOMReaderLimit(contentType, body, 1000+mtomPartOverhead)
+	require.NoError(t, err)
+	assert.Len(t, r.Envelope, 1000)
+
+	// The parts before the root are held in memory, even empty ones
+	var parts []mtomPart
+	for i := 0; i < 100; i++ {
+		parts = append(parts, mtomPart{id: strings.Repeat("x", i+1)})
+	}
+	contentType, body = newMTOM(t, "root", append(parts, root)...)
+	_, err = NewMTOMReaderLimit(contentType, body, 10*1024)
+	assert.ErrorIs(t, err, ErrMTOMBufferFull)
+
+	// Unbounded
+	contentType, body = newMTOM(t, "root", append(parts, root)...)
+	_, err = NewMTOMReaderLimit(contentType, body, -1)
+	assert.NoError(t, err)
+}
+
+func TestMTOMReaderLimitSkippedAttachments(t *testing.T) {
+	parts := []mtomPart{
+		{"root", "<Envelope/>"},
+		{"large", strings.Repeat("a", 4096)},
+		{"small", "small"},
+	}
+	limit := int64(len("<Envelope/>") + 2*mtomPartOverhead + 1024)
+
+	// Streamed in order, the attachments are not held in memory
+	contentType, body := newMTOM(t, "root", parts...)
+	r, err := NewMTOMReaderLimit(contentType, body, limit)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r.Open("cid:large"))
+	require.NoError(t, err)
+	assert.Len(t, data, 4096)
+
+	// Out of order, the large one is held in memory to reach the small one
+	contentType, body = newMTOM(t, "root", parts...)
+	r, err = NewMTOMReaderLimit(contentType, body, limit)
+	require.NoError(t, err)
+	small := r.Open("cid:small")
+	r.Open("cid:large")
+	_, err = io.ReadAll(small)
+	assert.ErrorIs(t, err, ErrMTOMBufferFull)
+}
@@ -2,7 +2,9 @@ package gosoap
 
 import (
 	"encoding/xml"
+	"errors"
 	"log"
+	"time"
 
 	"github.com/beevik/etree"
 )
@@ -10,6 +12,12 @@ import (
 //SoapMessage type from string
 type SoapMessage string
 
+// Namespaces of the SOAP envelopes
+const (
+	SOAP11EnvelopeNamespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	SOAP12EnvelopeNamespace = "http://www.w3.org/2003/05/soap-envelope"
+)
+
 // NewEmptySOAP return new SoapMessage
 func NewEmptySOAP() SoapMessage {
 	doc := buildSoapRoot()
@@ -20,6 +28,16 @@ func NewEmptySOAP() SoapMessage {
 	return SoapMessage(res)
 }
 
+// NewEmptySOAP11 return new SoapMessage with a SOAP 1.1 envelope, for the
+// legacy devices that do not understand SOAP 1.2
+func NewEmptySOAP11() SoapMessage {
+	doc := buildSoapRootNS(SOAP11EnvelopeNamespace, "http://schemas.xmlsoap.org/soap/encoding/")
+
+	res, _ := doc.WriteToString()
+
+	return SoapMessage(res)
+}
+
 //NewSOAP Get a new soap message
 func NewSOAP(headContent []*etree.Element, bodyContent []*etree.Element, namespaces map[string]string) SoapMessage {
 	doc := buildSoapRoot()
@@ -35,199 +53,230 @@ func (msg SoapMessage) String() string {
 }
 
 //StringIndent handle indent
+//
+// Deprecated: use StringIndentErr, which reports a malformed message.
 func (msg SoapMessage) StringIndent() string {
-	doc := etree.NewDocument()
+	res, err := msg.StringIndentErr()
+	if err != nil {
+		log.Println(err.Error())
+	}
+	return res
+}
 
+// StringIndentErr returns the message indented with tabs
+func (msg SoapMessage) StringIndentErr() (string, error) {
+	doc := etree.NewDocument()
 	if err := doc.ReadFromString(msg.String()); err != nil {
-		log.Println(err.Error())
+		return "", err
 	}
 
 	doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	return res
+	return doc.WriteToString()
 }
 
 //Body return body from Envelope
+//
+// Deprecated: use BodyErr, which reports a malformed message.
 func (msg SoapMessage) Body() string {
+	res, err := msg.BodyErr()
+	if err != nil {
+		log.Println(err.Error())
+	}
+	return res
+}
 
+// BodyErr returns the first element of the Body, indented with tabs
+func (msg SoapMessage) BodyErr() (string, error) {
 	doc := etree.NewDocument()
-
 	if err := doc.ReadFromString(msg.String()); err != nil {
-		log.Println(err.Error())
+		return "", err
+	}
+	body, err := soapElement(doc, "Body")
+	if err != nil {
+		return "", err
 	}
-	bodyTag := doc.Root().SelectElement("Body").ChildElements()[0]
-	doc.SetRoot(bodyTag)
+	children := body.ChildElements()
+	if len(children) == 0 {
+		return "", errors.New("empty SOAP Body")
+	}
+	doc.SetRoot(children[0])
 	doc.IndentTabs()
 
-	res, _ := doc.WriteToString()
-
-	return res
+	return doc.WriteToString()
 }
 
 //AddStringBodyContent for Envelope
+//
+// Deprecated: use AddStringBodyContentErr, which reports a malformed content.
 func (msg *SoapMessage) AddStringBodyContent(data string) {
-	doc := etree.NewDocument()
-
-	if err := doc.ReadFromString(data); err != nil {
+	if err := msg.AddStringBodyContentErr(data); err != nil {
 		log.Println(err.Error())
 	}
+}
 
-	element := doc.Root()
-
-	doc = etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
-		log.Println(err.Error())
+// AddStringBodyContentErr parses data and appends its root element to the Body
+func (msg *SoapMessage) AddStringBodyContentErr(data string) error {
+	element, err := parseElement(data)
+	if err != nil {
+		return err
 	}
-	//doc.FindElement("./Envelope/Body").AddChild(element)
-	bodyTag := doc.Root().SelectElement("Body")
-	bodyTag.AddChild(element)
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	*msg = SoapMessage(res)
+	return msg.AddBodyContentsErr([]*etree.Element{element})
 }
 
 //AddBodyContent for Envelope
+//
+// Deprecated: use AddBodyContentErr, which reports a malformed message.
 func (msg *SoapMessage) AddBodyContent(element *etree.Element) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
+	if err := msg.AddBodyContentErr(element); err != nil {
 		log.Println(err.Error())
 	}
-	//doc.FindElement("./Envelope/Body").AddChild(element)
-	bodyTag := doc.Root().SelectElement("Body")
-	bodyTag.AddChild(element)
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
+}
 
-	*msg = SoapMessage(res)
+// AddBodyContentErr appends element to the Body
+func (msg *SoapMessage) AddBodyContentErr(element *etree.Element) error {
+	return msg.AddBodyContentsErr([]*etree.Element{element})
 }
 
 //AddBodyContents for Envelope body
+//
+// Deprecated: use AddBodyContentsErr, which reports a malformed message.
 func (msg *SoapMessage) AddBodyContents(elements []*etree.Element) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
+	if err := msg.AddBodyContentsErr(elements); err != nil {
 		log.Println(err.Error())
 	}
+}
 
-	bodyTag := doc.Root().SelectElement("Body")
-
-	if len(elements) != 0 {
+// AddBodyContentsErr appends elements to the Body
+func (msg *SoapMessage) AddBodyContentsErr(elements []*etree.Element) error {
+	return msg.edit(func(doc *etree.Document) error {
+		body, err := soapElement(doc, "Body")
+		if err != nil {
+			return err
+		}
 		for _, j := range elements {
-			bodyTag.AddChild(j)
+			body.AddChild(j)
 		}
-	}
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	*msg = SoapMessage(res)
+		return nil
+	})
 }
 
 //AddStringHeaderContent for Envelope body
 func (msg *SoapMessage) AddStringHeaderContent(data string) error {
-	doc := etree.NewDocument()
-
-	if err := doc.ReadFromString(data); err != nil {
-		//log.Println(err.Error())
-		return err
-	}
-
-	element := doc.Root()
-
-	doc = etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
-		//log.Println(err.Error())
+	element, err := parseElement(data)
+	if err != nil {
 		return err
 	}
-
-	bodyTag := doc.Root().SelectElement("Header")
-	bodyTag.AddChild(element)
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	*msg = SoapMessage(res)
-
-	return nil
+	return msg.AddHeaderContentsErr([]*etree.Element{element})
 }
 
 //AddHeaderContent for Envelope body
+//
+// Deprecated: use AddHeaderContentErr, which reports a malformed message.
 func (msg *SoapMessage) AddHeaderContent(element *etree.Element) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
+	if err := msg.AddHeaderContentErr(element); err != nil {
 		log.Println(err.Error())
 	}
+}
 
-	bodyTag := doc.Root().SelectElement("Header")
-	bodyTag.AddChild(element)
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	*msg = SoapMessage(res)
+// AddHeaderContentErr appends element to the Header
+func (msg *SoapMessage) AddHeaderContentErr(element *etree.Element) error {
+	return msg.AddHeaderContentsErr([]*etree.Element{element})
 }
 
 //AddHeaderContents for Envelope body
+//
+// Deprecated: use AddHeaderContentsErr, which reports a malformed message.
 func (msg *SoapMessage) AddHeaderContents(elements []*etree.Element) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
+	if err := msg.AddHeaderContentsErr(elements); err != nil {
 		log.Println(err.Error())
 	}
+}
 
-	headerTag := doc.Root().SelectElement("Header")
-
-	if len(elements) != 0 {
+// AddHeaderContentsErr appends elements to the Header
+func (msg *SoapMessage) AddHeaderContentsErr(elements []*etree.Element) error {
+	return msg.edit(func(doc *etree.Document) error {
+		header, err := soapElement(doc, "Header")
+		if err != nil {
+			return err
+		}
 		for _, j := range elements {
-			headerTag.AddChild(j)
+			header.AddChild(j)
 		}
-	}
-
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
-
-	*msg = SoapMessage(res)
+		return nil
+	})
 }
 
 //AddRootNamespace for Envelope body
+//
+// Deprecated: use AddRootNamespaceErr, which reports a malformed message.
 func (msg *SoapMessage) AddRootNamespace(key, value string) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
+	if err := msg.AddRootNamespaceErr(key, value); err != nil {
 		log.Println(err.Error())
 	}
-	doc.Root().CreateAttr("xmlns:"+key, value)
-	//doc.IndentTabs()
-	res, _ := doc.WriteToString()
+}
 
-	*msg = SoapMessage(res)
+// AddRootNamespaceErr declares a namespace prefix on the Envelope element
+func (msg *SoapMessage) AddRootNamespaceErr(key, value string) error {
+	return msg.AddRootNamespacesErr(map[string]string{key: value})
 }
 
 //AddRootNamespaces for Envelope body
+//
+// Deprecated: use AddRootNamespacesErr, which reports a malformed message.
 func (msg *SoapMessage) AddRootNamespaces(namespaces map[string]string) {
-	for key, value := range namespaces {
-		msg.AddRootNamespace(key, value)
+	if err := msg.AddRootNamespacesErr(namespaces); err != nil {
+		log.Println(err.Error())
 	}
+}
 
-	/*
-		doc := etree.NewDocument()
-		if err := doc.ReadFromString(msg.String()); err != nil {
-			//log.Println(err.Error())
-			return err
+// AddRootNamespacesErr declares the namespace prefixes on the Envelope element
+func (msg *SoapMessage) AddRootNamespacesErr(namespaces map[string]string) error {
+	return msg.edit(func(doc *etree.Document) error {
+		root := doc.Root()
+		if root == nil {
+			return errors.New("empty SOAP message")
 		}
-
 		for key, value := range namespaces {
-			doc.Root().CreateAttr("xmlns:" + key, value)
+			root.CreateAttr("xmlns:"+key, value)
 		}
+		return nil
+	})
+}
 
-		doc.IndentTabs()
-		res, _ := doc.WriteToString()
+// edit parses the message, applies f to it and serializes it back. The
+// message is left unchanged when either fails.
+func (msg *SoapMessage) edit(f func(doc *etree.Document) error) error {
+	doc := etree.NewDocument()
+	if err := doc.ReadFromString(msg.String()); err != nil {
+		return err
+	}
+	if err := f(doc); err != nil {
+		return err
+	}
 
-		*msg = SoapMessage(res)*/
+	res, err := doc.WriteToString()
+	if err != nil {
+		return err
+	}
+	*msg = SoapMessage(res)
+	return nil
+}
+
+// soapElement returns the child of the Envelope with the given name
+func soapElement(doc *etree.Document, name string) (*etree.Element, error) {
+	if root := doc.Root(); root != nil {
+		if e := root.SelectElement(name); e != nil {
+			return e, nil
+		}
+	}
+	return nil, errors.New("no SOAP " + name)
 }
 
 func buildSoapRoot() *etree.Document {
+	return buildSoapRootNS(SOAP12EnvelopeNamespace, "http://www.w3.org/2003/05/soap-encoding")
+}
+
+func buildSoapRootNS(envelopeNS, encodingNS string) *etree.Document {
 	doc := etree.NewDocument()
 
 	doc.CreateProcInst("xml", `version="1.0" encoding="UTF-8"`)
@@ -236,69 +285,40 @@ func buildSoapRoot() *etree.Document {
 	env.CreateElement("soap-env:Header")
 	env.CreateElement("soap-env:Body")
 
-	env.CreateAttr("xmlns:soap-env", "http://www.w3.org/2003/05/soap-envelope")
-	env.CreateAttr("xmlns:soap-enc", "http://www.w3.org/2003/05/soap-encoding")
+	env.CreateAttr("xmlns:soap-env", envelopeNS)
+	env.CreateAttr("xmlns:soap-enc", encodingNS)
 
 	return doc
 }
 
 //AddWSSecurity Header for soapMessage
+//
+// Deprecated: use AddWSSecurityErr, which reports a malformed message.
 func (msg *SoapMessage) AddWSSecurity(username, password string) {
-	//doc := etree.NewDocument()
-	//if err := doc.ReadFromString(msg.String()); err != nil {
-	//	log.Println(err.Error())
-	//}
-	/*
-		Getting an WS-Security struct representation
-	*/
-	auth := NewSecurity(username, password)
+	if err := msg.AddWSSecurityErr(username, password); err != nil {
+		log.Println(err.Error())
+	}
+}
 
+// AddWSSecurityErr adds a WS-UsernameToken created now to the Header
+func (msg *SoapMessage) AddWSSecurityErr(username, password string) error {
+	return msg.AddWSSecurityAt(username, password, time.Now())
+}
+
+//AddWSSecurityAt Header for soapMessage, with a token created at the given time
+func (msg *SoapMessage) AddWSSecurityAt(username, password string, created time.Time) error {
 	/*
-		Adding WS-Security namespaces to root element of SOAP message
+		Getting an WS-Security struct representation
 	*/
-	//msg.AddRootNamespace("wsse", "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext1.0.xsd")
-	//msg.AddRootNamespace("wsu", "http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility1.0.xsd")
+	auth := NewSecurityAt(username, password, created)
 
 	soapReq, err := xml.MarshalIndent(auth, "", "  ")
 	if err != nil {
-		//log.Printf("error: %v\n", err.Error())
-		panic(err)
+		return err
 	}
 
 	/*
 		Adding WS-Security struct to SOAP header
 	*/
-	msg.AddStringHeaderContent(string(soapReq))
-
-	//doc.IndentTabs()
-	//res, _ := doc.WriteToString()
-	//
-	//*msg = SoapMessage(res)
-}
-
-//AddAction Header handling for soapMessage
-func (msg *SoapMessage) AddAction() {
-
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg.String()); err != nil {
-		log.Println(err.Error())
-	}
-	// opetaionTag := doc.Root().SelectElement("Body")
-
-	// firstElemnt := opetaionTag.Child[0]
-
-	// soapReq, err := xml.MarshalIndent(action, "", "  ")
-	// if err != nil {
-	// 	//log.Printf("error: %v\n", err.Error())
-	// 	panic(err)
-	// }
-	// /*
-	// 	Adding WS-Security struct to SOAP header
-	// */
-	// msg.AddStringHeaderContent(string(soapReq))
-
-	// //doc.IndentTabs()
-	// //res, _ := doc.WriteToString()
-	// //
-	// //*msg = SoapMessage(res)
+	return msg.AddStringHeaderContent(string(soapReq))
 }
@@ -0,0 +1,34 @@
+package gosoap
+
+import (
+	"bytes"
+	"log"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeprecatedMutatorsLogErrors(t *testing.T) {
+	var logged bytes.Buffer
+	defer log.SetOutput(log.Writer())
+	log.SetOutput(&logged)
+
+	msg := NewEmptySOAP()
+	before := msg
+	msg.AddStringBodyContent("<Unclosed")
+	msg.AddStringBodyContent("no element")
+	assert.Equal(t, before, msg, "the message is left unchanged")
+	assert.Equal(t, 2, bytes.Count(logged.Bytes(), []byte("\n")))
+
+	logged.Reset()
+	msg = SoapMessage("not xml")
+	msg.AddRootNamespace("tds", "http://www.onvif.org/ver10/device/wsdl")
+	assert.Equal(t, "", msg.Body())
+	assert.Equal(t, 2, bytes.Count(logged.Bytes(), []byte("\n")))
+
+	logged.Reset()
+	msg = NewEmptySOAP()
+	msg.AddStringBodyContent(`<GetProfiles xmlns="http://www.onvif.org/ver10/media/wsdl"/>`)
+	assert.Contains(t, msg.Body(), "GetProfiles")
+	assert.Empty(t, logged.String())
+}
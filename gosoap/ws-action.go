@@ -4,13 +4,21 @@ import (
 	"encoding/xml"
 )
 
-//Xlmns XML Scheam
-var actionHeaders = map[string]string{
-	"wsnt:Subscribe":     "http://docs.oasis-open.org/wsn/bw-2/NotificationProducer/SubscribeRequest",
-	"ResumeSubscription": "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/ResumeSubscriptionRequest",
-	"PauseSubscription":  "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/PauseSubscriptionRequest",
-	"Unsubscribe":        "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/UnsubscribeRequest",
-	"RenewRequest":       "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/RenewRequest",
+// wsdlActions are the actions of the operations whose WSDL does not name them
+// after the namespace and the operation, as the event service does
+var wsdlActions = map[string]string{
+	"http://www.onvif.org/ver10/events/wsdl/GetServiceCapabilities":      "http://www.onvif.org/ver10/events/wsdl/EventPortType/GetServiceCapabilitiesRequest",
+	"http://www.onvif.org/ver10/events/wsdl/CreatePullPointSubscription": "http://www.onvif.org/ver10/events/wsdl/EventPortType/CreatePullPointSubscriptionRequest",
+	"http://www.onvif.org/ver10/events/wsdl/GetEventProperties":          "http://www.onvif.org/ver10/events/wsdl/EventPortType/GetEventPropertiesRequest",
+	"http://www.onvif.org/ver10/events/wsdl/PullMessages":                "http://www.onvif.org/ver10/events/wsdl/PullPointSubscription/PullMessagesRequest",
+	"http://www.onvif.org/ver10/events/wsdl/Seek":                        "http://www.onvif.org/ver10/events/wsdl/PullPointSubscription/SeekRequest",
+	"http://www.onvif.org/ver10/events/wsdl/SetSynchronizationPoint":     "http://www.onvif.org/ver10/events/wsdl/PullPointSubscription/SetSynchronizationPointRequest",
+	"http://docs.oasis-open.org/wsn/b-2/Subscribe":                       "http://docs.oasis-open.org/wsn/bw-2/NotificationProducer/SubscribeRequest",
+	"http://docs.oasis-open.org/wsn/b-2/GetCurrentMessage":               "http://docs.oasis-open.org/wsn/bw-2/NotificationProducer/GetCurrentMessageRequest",
+	"http://docs.oasis-open.org/wsn/b-2/Renew":                           "http://docs.oasis-open.org/wsn/bw-2/SubscriptionManager/RenewRequest",
+	"http://docs.oasis-open.org/wsn/b-2/Unsubscribe":                     "http://docs.oasis-open.org/wsn/bw-2/SubscriptionManager/UnsubscribeRequest",
+	"http://docs.oasis-open.org/wsn/b-2/PauseSubscription":               "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/PauseSubscriptionRequest",
+	"http://docs.oasis-open.org/wsn/b-2/ResumeSubscription":              "http://docs.oasis-open.org/wsn/bw-2/PausableSubscriptionManager/ResumeSubscriptionRequest",
 }
 
 /*************************
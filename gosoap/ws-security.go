@@ -57,12 +57,18 @@ type wsAuth struct {
 
 //NewSecurity get a new security
 func NewSecurity(username, passwd string) Security {
+	return NewSecurityAt(username, passwd, time.Now())
+}
+
+//NewSecurityAt get a new security stamped with the given creation time, e.g. the
+//local time corrected with the clock offset of the device
+func NewSecurityAt(username, passwd string, at time.Time) Security {
 	/** Generating Nonce sequence **/
 	charsToGenerate := 32
 	charSet := gostrgen.Lower | gostrgen.Digit
 
 	nonceSeq, _ := gostrgen.RandGen(charsToGenerate, charSet, "", "")
-	created := time.Now().UTC().Format(time.RFC3339Nano)
+	created := at.UTC().Format(time.RFC3339Nano)
 	auth := Security{
 		Auth: wsAuth{
 			Username: username,
@@ -0,0 +1,4 @@
+// Package media2 declares the types of the ONVIF Media2 service, generated from its WSDL.
+package media2
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../docs/wsdl/media2.wsdl -types -o types_auto.go media2
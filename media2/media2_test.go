@@ -0,0 +1,129 @@
+package media2
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getProfilesResponse = `<tr2:GetProfilesResponse xmlns:tr2="http://www.onvif.org/ver20/media/wsdl" xmlns:tt="http://www.onvif.org/ver10/schema">
+	<tr2:Profiles token="Profile_1" fixed="true">
+		<tr2:Name>mainStream</tr2:Name>
+		<tr2:Configurations>
+			<tr2:VideoSource token="VideoSourceToken">
+				<tt:Name>VideoSourceConfig</tt:Name>
+				<tt:UseCount>2</tt:UseCount>
+				<tt:SourceToken>VideoSource_1</tt:SourceToken>
+				<tt:Bounds x="0" y="0" width="1920" height="1080"></tt:Bounds>
+			</tr2:VideoSource>
+			<tr2:VideoEncoder token="VideoEncoderToken_1" GovLength="50" Profile="Main">
+				<tt:Name>VideoEncoder_1</tt:Name>
+				<tt:UseCount>1</tt:UseCount>
+				<tt:Encoding>H265</tt:Encoding>
+				<tt:Resolution><tt:Width>1920</tt:Width><tt:Height>1080</tt:Height></tt:Resolution>
+				<tt:RateControl ConstantBitRate="false"><tt:FrameRateLimit>25</tt:FrameRateLimit><tt:BitrateLimit>4096</tt:BitrateLimit></tt:RateControl>
+				<tt:Quality>4</tt:Quality>
+			</tr2:VideoEncoder>
+			<tr2:PTZ token="PTZToken">
+				<tt:Name>PTZ</tt:Name>
+				<tt:UseCount>2</tt:UseCount>
+				<tt:NodeToken>PTZNODETOKEN</tt:NodeToken>
+				<tt:DefaultAbsolutePantTiltPositionSpace>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:DefaultAbsolutePantTiltPositionSpace>
+				<tt:DefaultPTZSpeed>
+					<tt:PanTilt x="0.5" y="0.5" space="http://www.onvif.org/ver10/tptz/PanTiltSpaces/GenericSpeedSpace"></tt:PanTilt>
+					<tt:Zoom x="1" space="http://www.onvif.org/ver10/tptz/ZoomSpaces/ZoomGenericSpeedSpace"></tt:Zoom>
+				</tt:DefaultPTZSpeed>
+				<tt:DefaultPTZTimeout>PT5S</tt:DefaultPTZTimeout>
+				<tt:PanTiltLimits><tt:Range>
+					<tt:URI>http://www.onvif.org/ver10/tptz/PanTiltSpaces/PositionGenericSpace</tt:URI>
+					<tt:XRange><tt:Min>-1</tt:Min><tt:Max>1</tt:Max></tt:XRange>
+					<tt:YRange><tt:Min>-0.5</tt:Min><tt:Max>0.5</tt:Max></tt:YRange>
+				</tt:Range></tt:PanTiltLimits>
+				<tt:Extension><tt:PTControlDirection>
+					<tt:EFlip><tt:Mode>OFF</tt:Mode></tt:EFlip>
+					<tt:Reverse><tt:Mode>AUTO</tt:Mode></tt:Reverse>
+				</tt:PTControlDirection></tt:Extension>
+			</tr2:PTZ>
+		</tr2:Configurations>
+	</tr2:Profiles>
+	<tr2:Profiles token="Profile_2" fixed="false">
+		<tr2:Name>subStream</tr2:Name>
+		<tr2:Configurations></tr2:Configurations>
+	</tr2:Profiles>
+</tr2:GetProfilesResponse>`
+
+func TestGetProfilesResponseUnmarshal(t *testing.T) {
+	var resp GetProfilesResponse
+	require.NoError(t, xml.Unmarshal([]byte(getProfilesResponse), &resp))
+	require.Len(t, resp.Profiles, 2)
+
+	profile := resp.Profiles[0]
+	assert.Equal(t, onvif.ReferenceToken("Profile_1"), profile.Token)
+	assert.True(t, bool(profile.Fixed))
+	assert.Equal(t, onvif.Name("mainStream"), profile.Name)
+
+	source := profile.Configurations.VideoSource
+	assert.Equal(t, onvif.Name("VideoSourceConfig"), source.Name)
+	assert.Equal(t, 2, source.UseCount)
+	assert.Equal(t, onvif.ReferenceToken("VideoSource_1"), source.SourceToken)
+	assert.Equal(t, 1920, source.Bounds.Width)
+
+	encoder := profile.Configurations.VideoEncoder
+	assert.Equal(t, onvif.ReferenceToken("VideoEncoderToken_1"), encoder.Token)
+	assert.Equal(t, "H265", encoder.Encoding)
+	assert.Equal(t, 50, encoder.GovLength)
+	assert.EqualValues(t, 1080, encoder.Resolution.Height)
+	require.NotNil(t, encoder.RateControl)
+	assert.EqualValues(t, 4096, encoder.RateControl.BitrateLimit)
+
+	ptz := profile.Configurations.PTZ
+	assert.Equal(t, onvif.Name("PTZ"), ptz.Name)
+	assert.Equal(t, 2, ptz.UseCount)
+	assert.Equal(t, onvif.ReferenceToken("PTZNODETOKEN"), ptz.NodeToken)
+	assert.Equal(t, 0.5, ptz.DefaultPTZSpeed.PanTilt.X)
+	assert.Equal(t, 1.0, ptz.DefaultPTZSpeed.Zoom.X)
+	assert.Equal(t, -1.0, ptz.PanTiltLimits.Range.XRange.Min)
+	assert.Equal(t, 0.5, ptz.PanTiltLimits.Range.YRange.Max)
+	assert.Equal(t, onvif.EFlipMode("OFF"), ptz.Extension.PTControlDirection.EFlip.Mode)
+	assert.Equal(t, onvif.ReverseMode("AUTO"), ptz.Extension.PTControlDirection.Reverse.Mode)
+
+	assert.Equal(t, onvif.Name("subStream"), resp.Profiles[1].Name)
+	assert.False(t, bool(resp.Profiles[1].Fixed))
+}
+
+func TestGetStreamUriResponseUnmarshal(t *testing.T) {
+	const reply = `<tr2:GetStreamUriResponse xmlns:tr2="http://www.onvif.org/ver20/media/wsdl">
+		<tr2:Uri>rtsp://192.168.0.10:554/Streaming/Channels/101?transportmode=unicast</tr2:Uri>
+	</tr2:GetStreamUriResponse>`
+	var resp GetStreamUriResponse
+	require.NoError(t, xml.Unmarshal([]byte(reply), &resp))
+	assert.Equal(t, "rtsp://192.168.0.10:554/Streaming/Channels/101?transportmode=unicast", string(resp.Uri))
+
+	data, err := xml.Marshal(GetStreamUri{Protocol: "RTSP", ProfileToken: "Profile_1"})
+	require.NoError(t, err)
+	assert.Equal(t, `<tr2:GetStreamUri><tr2:Protocol>RTSP</tr2:Protocol><tr2:ProfileToken>Profile_1</tr2:ProfileToken></tr2:GetStreamUri>`, string(data))
+}
+
+func TestGetVideoSourceModesResponseUnmarshal(t *testing.T) {
+	const reply = `<tr2:GetVideoSourceModesResponse xmlns:tr2="http://www.onvif.org/ver20/media/wsdl" xmlns:tt="http://www.onvif.org/ver10/schema">
+		<tr2:VideoSourceModes token="Mode_1" Enabled="true">
+			<tr2:MaxFramerate>30</tr2:MaxFramerate>
+			<tr2:MaxResolution><tt:Width>2688</tt:Width><tt:Height>1520</tt:Height></tr2:MaxResolution>
+			<tr2:Encodings>H264 H265</tr2:Encodings>
+			<tr2:Reboot>true</tr2:Reboot>
+		</tr2:VideoSourceModes>
+	</tr2:GetVideoSourceModesResponse>`
+	var resp GetVideoSourceModesResponse
+	require.NoError(t, xml.Unmarshal([]byte(reply), &resp))
+	require.Len(t, resp.VideoSourceModes, 1)
+	mode := resp.VideoSourceModes[0]
+	assert.Equal(t, onvif.ReferenceToken("Mode_1"), mode.Token)
+	assert.EqualValues(t, 30, mode.MaxFramerate)
+	assert.EqualValues(t, 2688, mode.MaxResolution.Width)
+	assert.EqualValues(t, 1520, mode.MaxResolution.Height)
+	assert.True(t, bool(mode.Reboot))
+}
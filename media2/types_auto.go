@@ -0,0 +1,817 @@
+// Code generated by sdk/codegen from media2.wsdl : DO NOT EDIT.
+
+package media2
+
+import (
+	"encoding/xml"
+
+	"github.com/BalkarSandhu/go-onvif/xsd"
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+)
+
+// GetServiceCapabilities is the request of the GetServiceCapabilities operation. Returns the
+// capabilities of the media service. The result is returned in a typed answer.
+type GetServiceCapabilities struct {
+	XMLName string `xml:"tr2:GetServiceCapabilities"`
+}
+
+// GetServiceCapabilitiesResponse is the reply to the GetServiceCapabilities operation.
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities2 `xml:"Capabilities"`
+}
+
+// CreateProfile is the request of the CreateProfile operation. This operation creates a new media
+// profile. A created profile created via this method may be deleted via the DeleteProfile method.
+// Optionally Configurations can be assinged to the profile on creation. For details regarding
+// profile assignement check also the method AddConfiguration.
+type CreateProfile struct {
+	XMLName       string             `xml:"tr2:CreateProfile"`
+	Name          onvif.Name         `xml:"tr2:Name"`
+	Configuration []ConfigurationRef `xml:"tr2:Configuration"`
+}
+
+// CreateProfileResponse is the reply to the CreateProfile operation.
+type CreateProfileResponse struct {
+	Token onvif.ReferenceToken `xml:"Token"`
+}
+
+// GetProfiles is the request of the GetProfiles operation. Retrieve the profile with the specified
+// token or all defined media profiles.
+type GetProfiles struct {
+	XMLName string               `xml:"tr2:GetProfiles"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	Type    []xsd.String         `xml:"tr2:Type"`
+}
+
+// GetProfilesResponse is the reply to the GetProfiles operation.
+type GetProfilesResponse struct {
+	Profiles []MediaProfile `xml:"Profiles"`
+}
+
+// AddConfiguration is the request of the AddConfiguration operation. This operation adds one or
+// more Configurations to an existing media profile. If a configuration exists in the media profile,
+// it will be replaced. A device shall support adding a compatible Configuration to a Profile
+// containing a VideoSourceConfiguration and shall support streaming video data of such a profile.
+// Note that OSD elements must be added via the CreateOSD command.
+type AddConfiguration struct {
+	XMLName       string               `xml:"tr2:AddConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Name          onvif.Name           `xml:"tr2:Name,omitempty"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+// AddConfigurationResponse is the reply to the AddConfiguration operation.
+type AddConfigurationResponse struct {
+}
+
+// RemoveConfiguration is the request of the RemoveConfiguration operation. This operation removes
+// the listed configurations from an existing media profile. If the media profile does not contain
+// one of the listed configurations that item shall be ignored.
+type RemoveConfiguration struct {
+	XMLName       string               `xml:"tr2:RemoveConfiguration"`
+	ProfileToken  onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+	Configuration []ConfigurationRef   `xml:"tr2:Configuration"`
+}
+
+// RemoveConfigurationResponse is the reply to the RemoveConfiguration operation.
+type RemoveConfigurationResponse struct {
+}
+
+// DeleteProfile is the request of the DeleteProfile operation. This operation deletes a profile.
+// Deletion of a profile is only possible for non-fixed profiles
+type DeleteProfile struct {
+	XMLName string               `xml:"tr2:DeleteProfile"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+// DeleteProfileResponse is the reply to the DeleteProfile operation.
+type DeleteProfileResponse struct {
+}
+
+// GetVideoSourceConfigurations is the request of the GetVideoSourceConfigurations operation. By
+// default this operation lists all existing video source configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetVideoSourceConfigurations struct {
+	XMLName string `xml:"tr2:GetVideoSourceConfigurations"`
+	GetConfiguration
+}
+
+// GetVideoSourceConfigurationsResponse is the reply to the GetVideoSourceConfigurations operation.
+type GetVideoSourceConfigurationsResponse struct {
+	Configurations []onvif.VideoSourceConfiguration `xml:"Configurations"`
+}
+
+// GetVideoEncoderConfigurations is the request of the GetVideoEncoderConfigurations operation. By
+// default this operation lists all existing video encoder configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetVideoEncoderConfigurations struct {
+	XMLName string `xml:"tr2:GetVideoEncoderConfigurations"`
+	GetConfiguration
+}
+
+// GetVideoEncoderConfigurationsResponse is the reply to the GetVideoEncoderConfigurations
+// operation.
+type GetVideoEncoderConfigurationsResponse struct {
+	Configurations []onvif.VideoEncoder2Configuration `xml:"Configurations"`
+}
+
+// GetAudioSourceConfigurations is the request of the GetAudioSourceConfigurations operation. By
+// default this operation lists all existing audio source configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetAudioSourceConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioSourceConfigurations"`
+	GetConfiguration
+}
+
+// GetAudioSourceConfigurationsResponse is the reply to the GetAudioSourceConfigurations operation.
+type GetAudioSourceConfigurationsResponse struct {
+	Configurations []onvif.AudioSourceConfiguration `xml:"Configurations"`
+}
+
+// GetAudioEncoderConfigurations is the request of the GetAudioEncoderConfigurations operation. By
+// default this operation lists all existing audio encoder configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetAudioEncoderConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioEncoderConfigurations"`
+	GetConfiguration
+}
+
+// GetAudioEncoderConfigurationsResponse is the reply to the GetAudioEncoderConfigurations
+// operation.
+type GetAudioEncoderConfigurationsResponse struct {
+	Configurations []onvif.AudioEncoder2Configuration `xml:"Configurations"`
+}
+
+// GetAnalyticsConfigurations is the request of the GetAnalyticsConfigurations operation. By default
+// this operation lists all existing video analytics configurations for a device. Provide a profile
+// token to list only configurations that are compatible with the profile. If a configuration token
+// is provided only a single configuration will be returned.
+type GetAnalyticsConfigurations struct {
+	XMLName string `xml:"tr2:GetAnalyticsConfigurations"`
+	GetConfiguration
+}
+
+// GetAnalyticsConfigurationsResponse is the reply to the GetAnalyticsConfigurations operation.
+type GetAnalyticsConfigurationsResponse struct {
+	Configurations []onvif.VideoAnalyticsConfiguration `xml:"Configurations"`
+}
+
+// GetMetadataConfigurations is the request of the GetMetadataConfigurations operation. By default
+// this operation lists all existing metadata configurations for a device. Provide a profile token
+// to list only configurations that are compatible with the profile. If a configuration token is
+// provided only a single configuration will be returned.
+type GetMetadataConfigurations struct {
+	XMLName string `xml:"tr2:GetMetadataConfigurations"`
+	GetConfiguration
+}
+
+// GetMetadataConfigurationsResponse is the reply to the GetMetadataConfigurations operation.
+type GetMetadataConfigurationsResponse struct {
+	Configurations []onvif.MetadataConfiguration `xml:"Configurations"`
+}
+
+// GetAudioOutputConfigurations is the request of the GetAudioOutputConfigurations operation. By
+// default this operation lists all existing audio output configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetAudioOutputConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioOutputConfigurations"`
+	GetConfiguration
+}
+
+// GetAudioOutputConfigurationsResponse is the reply to the GetAudioOutputConfigurations operation.
+type GetAudioOutputConfigurationsResponse struct {
+	Configurations []onvif.AudioOutputConfiguration `xml:"Configurations"`
+}
+
+// GetAudioDecoderConfigurations is the request of the GetAudioDecoderConfigurations operation. By
+// default this operation lists all existing audio decoder configurations for a device. Provide a
+// profile token to list only configurations that are compatible with the profile. If a
+// configuration token is provided only a single configuration will be returned.
+type GetAudioDecoderConfigurations struct {
+	XMLName string `xml:"tr2:GetAudioDecoderConfigurations"`
+	GetConfiguration
+}
+
+// GetAudioDecoderConfigurationsResponse is the reply to the GetAudioDecoderConfigurations
+// operation.
+type GetAudioDecoderConfigurationsResponse struct {
+	Configurations []onvif.AudioDecoderConfiguration `xml:"Configurations"`
+}
+
+// SetVideoSourceConfiguration is the request of the SetVideoSourceConfiguration operation. This
+// operation modifies a video source configuration. Running streams using this configuration may be
+// immediately updated according to the new settings. The changes are not guaranteed to take effect
+// unless the client requests a new stream URI and restarts any affected stream. NVC methods for
+// changing a running stream are out of scope for this specification.
+type SetVideoSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetVideoSourceConfiguration"`
+	Configuration onvif.VideoSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+// SetVideoSourceConfigurationResponse is the reply to the SetVideoSourceConfiguration operation.
+type SetVideoSourceConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetVideoEncoderConfiguration is the request of the SetVideoEncoderConfiguration operation. This
+// operation modifies a video encoder configuration. Running streams using this configuration may be
+// immediately updated according to the new settings. The changes are not guaranteed to take effect
+// unless the client requests a new stream URI and restarts any affected stream. NVC methods for
+// changing a running stream are out of scope for this specification. SessionTimeout is provided as
+// a hint for keeping rtsp session by a device. If necessary the device may adapt parameter values
+// for SessionTimeout elements without returning an error. For the time between keep alive calls the
+// client shall adhere to the timeout value signaled via RTSP.
+type SetVideoEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetVideoEncoderConfiguration"`
+	Configuration onvif.VideoEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+// SetVideoEncoderConfigurationResponse is the reply to the SetVideoEncoderConfiguration operation.
+type SetVideoEncoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetAudioSourceConfiguration is the request of the SetAudioSourceConfiguration operation. This
+// operation modifies an audio source configuration. Running streams using this configuration may be
+// immediately updated according to the new settings. The changes are not guaranteed to take effect
+// unless the client requests a new stream URI and restarts any affected stream NVC methods for
+// changing a running stream are out of scope for this specification.
+type SetAudioSourceConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioSourceConfiguration"`
+	Configuration onvif.AudioSourceConfiguration `xml:"tr2:Configuration"`
+}
+
+// SetAudioSourceConfigurationResponse is the reply to the SetAudioSourceConfiguration operation.
+type SetAudioSourceConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetAudioEncoderConfiguration is the request of the SetAudioEncoderConfiguration operation. This
+// operation modifies an audio encoder configuration. Running streams using this configuration may
+// be immediately updated according to the new settings. The changes are not guaranteed to take
+// effect unless the client requests a new stream URI and restarts any affected streams. NVC methods
+// for changing a running stream are out of scope for this specification.
+type SetAudioEncoderConfiguration struct {
+	XMLName       string                           `xml:"tr2:SetAudioEncoderConfiguration"`
+	Configuration onvif.AudioEncoder2Configuration `xml:"tr2:Configuration"`
+}
+
+// SetAudioEncoderConfigurationResponse is the reply to the SetAudioEncoderConfiguration operation.
+type SetAudioEncoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetMetadataConfiguration is the request of the SetMetadataConfiguration operation. This operation
+// modifies a metadata configuration. Running streams using this configuration may be updated
+// immediately according to the new settings. The changes are not guaranteed to take effect unless
+// the client requests a new stream URI and restarts any affected streams. NVC methods for changing
+// a running stream are out of scope for this specification.
+type SetMetadataConfiguration struct {
+	XMLName       string                      `xml:"tr2:SetMetadataConfiguration"`
+	Configuration onvif.MetadataConfiguration `xml:"tr2:Configuration"`
+}
+
+// SetMetadataConfigurationResponse is the reply to the SetMetadataConfiguration operation.
+type SetMetadataConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetAudioOutputConfiguration is the request of the SetAudioOutputConfiguration operation. This
+// operation modifies an audio output configuration.
+type SetAudioOutputConfiguration struct {
+	XMLName       string                         `xml:"tr2:SetAudioOutputConfiguration"`
+	Configuration onvif.AudioOutputConfiguration `xml:"tr2:Configuration"`
+}
+
+// SetAudioOutputConfigurationResponse is the reply to the SetAudioOutputConfiguration operation.
+type SetAudioOutputConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// SetAudioDecoderConfiguration is the request of the SetAudioDecoderConfiguration operation. This
+// operation modifies an audio decoder configuration.
+type SetAudioDecoderConfiguration struct {
+	XMLName       string                          `xml:"tr2:SetAudioDecoderConfiguration"`
+	Configuration onvif.AudioDecoderConfiguration `xml:"tr2:Configuration"`
+}
+
+// SetAudioDecoderConfigurationResponse is the reply to the SetAudioDecoderConfiguration operation.
+type SetAudioDecoderConfigurationResponse struct {
+	SetConfigurationResponse
+}
+
+// GetVideoSourceConfigurationOptions is the request of the GetVideoSourceConfigurationOptions
+// operation. This operation returns the available options (supported values and ranges for video
+// source configuration parameters) when the video source parameters are reconfigured If a video
+// source configuration is specified, the options shall concern that particular configuration. If a
+// media profile is specified, the options shall be compatible with that media profile.
+type GetVideoSourceConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetVideoSourceConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetVideoSourceConfigurationOptionsResponse is the reply to the GetVideoSourceConfigurationOptions
+// operation.
+type GetVideoSourceConfigurationOptionsResponse struct {
+	Options onvif.VideoSourceConfigurationOptions `xml:"Options"`
+}
+
+// GetVideoEncoderConfigurationOptions is the request of the GetVideoEncoderConfigurationOptions
+// operation. This operation returns the available options (supported values and ranges for video
+// encoder configuration parameters) when the video encoder parameters are reconfigured. For JPEG,
+// MPEG4 and H264 extension elements have been defined that provide additional information. A device
+// must provide the XxxOption information for all encodings supported and should additionally
+// provide the corresponding XxxOption2 information. This response contains the available video
+// encoder configuration options. If a video encoder configuration is specified, the options shall
+// concern that particular configuration. If a media profile is specified, the options shall be
+// compatible with that media profile. If no tokens are specified, the options shall be considered
+// generic for the device.
+type GetVideoEncoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetVideoEncoderConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetVideoEncoderConfigurationOptionsResponse is the reply to the
+// GetVideoEncoderConfigurationOptions operation.
+type GetVideoEncoderConfigurationOptionsResponse struct {
+	Options []onvif.VideoEncoder2ConfigurationOptions `xml:"Options"`
+}
+
+// GetAudioSourceConfigurationOptions is the request of the GetAudioSourceConfigurationOptions
+// operation. This operation returns the available options (supported values and ranges for audio
+// source configuration parameters) when the audio source parameters are reconfigured. If an audio
+// source configuration is specified, the options shall concern that particular configuration. If a
+// media profile is specified, the options shall be compatible with that media profile.
+type GetAudioSourceConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioSourceConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetAudioSourceConfigurationOptionsResponse is the reply to the GetAudioSourceConfigurationOptions
+// operation.
+type GetAudioSourceConfigurationOptionsResponse struct {
+	Options onvif.AudioSourceConfigurationOptions `xml:"Options"`
+}
+
+// GetAudioEncoderConfigurationOptions is the request of the GetAudioEncoderConfigurationOptions
+// operation. This operation returns the available options (supported values and ranges for audio
+// encoder configuration parameters) when the audio encoder parameters are reconfigured.
+type GetAudioEncoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioEncoderConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetAudioEncoderConfigurationOptionsResponse is the reply to the
+// GetAudioEncoderConfigurationOptions operation.
+type GetAudioEncoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions `xml:"Options"`
+}
+
+// GetMetadataConfigurationOptions is the request of the GetMetadataConfigurationOptions operation.
+// This operation returns the available options (supported values and ranges for metadata
+// configuration parameters) for changing the metadata configuration.
+type GetMetadataConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetMetadataConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetMetadataConfigurationOptionsResponse is the reply to the GetMetadataConfigurationOptions
+// operation.
+type GetMetadataConfigurationOptionsResponse struct {
+	Options onvif.MetadataConfigurationOptions `xml:"Options"`
+}
+
+// GetAudioOutputConfigurationOptions is the request of the GetAudioOutputConfigurationOptions
+// operation. This operation returns the available options (supported values and ranges for audio
+// output configuration parameters) for configuring an audio output.
+type GetAudioOutputConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioOutputConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetAudioOutputConfigurationOptionsResponse is the reply to the GetAudioOutputConfigurationOptions
+// operation.
+type GetAudioOutputConfigurationOptionsResponse struct {
+	Options onvif.AudioOutputConfigurationOptions `xml:"Options"`
+}
+
+// GetAudioDecoderConfigurationOptions is the request of the GetAudioDecoderConfigurationOptions
+// operation. This command list the audio decoding capabilities for a given profile and
+// configuration of a device.
+type GetAudioDecoderConfigurationOptions struct {
+	XMLName string `xml:"tr2:GetAudioDecoderConfigurationOptions"`
+	GetConfiguration
+}
+
+// GetAudioDecoderConfigurationOptionsResponse is the reply to the
+// GetAudioDecoderConfigurationOptions operation.
+type GetAudioDecoderConfigurationOptionsResponse struct {
+	Options []onvif.AudioEncoder2ConfigurationOptions `xml:"Options"`
+}
+
+// GetVideoEncoderInstances is the request of the GetVideoEncoderInstances operation. The
+// GetVideoEncoderInstances command can be used to request the minimum number of guaranteed video
+// encoder instances (applications) per Video Source Configuration.
+type GetVideoEncoderInstances struct {
+	XMLName            string               `xml:"tr2:GetVideoEncoderInstances"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+// GetVideoEncoderInstancesResponse is the reply to the GetVideoEncoderInstances operation.
+type GetVideoEncoderInstancesResponse struct {
+	Info EncoderInstanceInfo `xml:"Info"`
+}
+
+// GetStreamUri is the request of the GetStreamUri operation. This operation requests a URI that can
+// be used to initiate a live media stream using RTSP as the control protocol. The returned URI
+// shall remain valid indefinitely even if the profile is changed. Defined stream types are If a
+// multicast stream is requested the VideoEncoderConfiguration, AudioEncoderConfiguration and
+// MetadataConfiguration element inside the corresponding media profile must be configured with
+// valid multicast settings. For full compatibility with other ONVIF services a device should not
+// generate Uris longer than 128 octets.
+type GetStreamUri struct {
+	XMLName      string               `xml:"tr2:GetStreamUri"`
+	Protocol     xsd.String           `xml:"tr2:Protocol"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+// GetStreamUriResponse is the reply to the GetStreamUri operation.
+type GetStreamUriResponse struct {
+	Uri xsd.AnyURI `xml:"Uri"`
+}
+
+// StartMulticastStreaming is the request of the StartMulticastStreaming operation. This command
+// starts multicast streaming using a specified media profile of a device. Streaming continues until
+// StopMulticastStreaming is called for the same Profile. The streaming shall continue after a
+// reboot of the device until a StopMulticastStreaming request is received. The multicast address,
+// port and TTL are configured in the VideoEncoderConfiguration, AudioEncoderConfiguration and
+// MetadataConfiguration respectively.
+type StartMulticastStreaming struct {
+	XMLName string `xml:"tr2:StartMulticastStreaming"`
+	StartStopMulticastStreaming
+}
+
+// StartMulticastStreamingResponse is the reply to the StartMulticastStreaming operation.
+type StartMulticastStreamingResponse struct {
+	SetConfigurationResponse
+}
+
+// StopMulticastStreaming is the request of the StopMulticastStreaming operation. This command stops
+// multicast streaming using a specified media profile of a device
+type StopMulticastStreaming struct {
+	XMLName string `xml:"tr2:StopMulticastStreaming"`
+	StartStopMulticastStreaming
+}
+
+// StopMulticastStreamingResponse is the reply to the StopMulticastStreaming operation.
+type StopMulticastStreamingResponse struct {
+	SetConfigurationResponse
+}
+
+// SetSynchronizationPoint is the request of the SetSynchronizationPoint operation. Synchronization
+// points allow clients to decode and correctly use all data after the synchronization point. For
+// example, if a video stream is configured with a large I-frame distance and a client loses a
+// single packet, the client does not display video until the next I-frame is transmitted. In such
+// cases, the client can request a Synchronization Point which enforces the device to add an I-Frame
+// as soon as possible. Clients can request Synchronization Points for profiles. The device shall
+// add synchronization points for all streams associated with this profile. Similarly, a
+// synchronization point is used to get an update on full PTZ or event status through the metadata
+// stream. If a video stream is associated with the profile, an I-frame shall be added to this video
+// stream. If a PTZ metadata stream is associated to the profile, the PTZ position shall be repeated
+// within the metadata stream.
+type SetSynchronizationPoint struct {
+	XMLName      string               `xml:"tr2:SetSynchronizationPoint"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+// SetSynchronizationPointResponse is the reply to the SetSynchronizationPoint operation.
+type SetSynchronizationPointResponse struct {
+}
+
+// GetSnapshotUri is the request of the GetSnapshotUri operation. A client uses the GetSnapshotUri
+// command to obtain a JPEG snapshot from the device. The returned URI shall remain valid
+// indefinitely even if the profile is changed. The ValidUntilConnect, ValidUntilReboot and Timeout
+// Parameter shall be set accordingly (ValidUntilConnect=false, ValidUntilReboot=false,
+// timeout=PT0S). The URI can be used for acquiring a JPEG image through a HTTP GET operation. The
+// image encoding will always be JPEG regardless of the encoding setting in the media profile. The
+// Jpeg settings (like resolution or quality) may be taken from the profile if suitable. The
+// provided image will be updated automatically and independent from calls to GetSnapshotUri.
+type GetSnapshotUri struct {
+	XMLName      string               `xml:"tr2:GetSnapshotUri"`
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+// GetSnapshotUriResponse is the reply to the GetSnapshotUri operation.
+type GetSnapshotUriResponse struct {
+	Uri xsd.AnyURI `xml:"Uri"`
+}
+
+// GetVideoSourceModes is the request of the GetVideoSourceModes operation. A device returns the
+// information for current video source mode and settable video source modes of specified video
+// source. A device that indicates a capability of VideoSourceModes shall support this command.
+type GetVideoSourceModes struct {
+	XMLName          string               `xml:"tr2:GetVideoSourceModes"`
+	VideoSourceToken onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+}
+
+// GetVideoSourceModesResponse is the reply to the GetVideoSourceModes operation.
+type GetVideoSourceModesResponse struct {
+	VideoSourceModes []VideoSourceMode `xml:"VideoSourceModes"`
+}
+
+// SetVideoSourceMode is the request of the SetVideoSourceMode operation. SetVideoSourceMode changes
+// the media profile structure relating to video source for the specified video source mode. A
+// device that indicates a capability of VideoSourceModes shall support this command. The behavior
+// after changing the mode is not defined in this specification.
+type SetVideoSourceMode struct {
+	XMLName              string               `xml:"tr2:SetVideoSourceMode"`
+	VideoSourceToken     onvif.ReferenceToken `xml:"tr2:VideoSourceToken"`
+	VideoSourceModeToken onvif.ReferenceToken `xml:"tr2:VideoSourceModeToken"`
+}
+
+// SetVideoSourceModeResponse is the reply to the SetVideoSourceMode operation.
+type SetVideoSourceModeResponse struct {
+	Reboot xsd.Boolean `xml:"Reboot"`
+}
+
+// GetOSDs is the request of the GetOSDs operation. This operation lists existing OSD configurations
+// for the device.
+type GetOSDs struct {
+	XMLName            string               `xml:"tr2:GetOSDs"`
+	OSDToken           onvif.ReferenceToken `xml:"tr2:OSDToken,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+// GetOSDsResponse is the reply to the GetOSDs operation.
+type GetOSDsResponse struct {
+	OSDs []onvif.OSDConfiguration `xml:"OSDs"`
+}
+
+// GetOSDOptions is the request of the GetOSDOptions operation. Get the OSD Options.
+type GetOSDOptions struct {
+	XMLName            string               `xml:"tr2:GetOSDOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+// GetOSDOptionsResponse is the reply to the GetOSDOptions operation.
+type GetOSDOptionsResponse struct {
+	OSDOptions onvif.OSDConfigurationOptions `xml:"OSDOptions"`
+}
+
+// SetOSD is the request of the SetOSD operation. Set the OSD
+type SetOSD struct {
+	XMLName string                 `xml:"tr2:SetOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+// SetOSDResponse is the reply to the SetOSD operation.
+type SetOSDResponse struct {
+	SetConfigurationResponse
+}
+
+// CreateOSD is the request of the CreateOSD operation. Create the OSD.
+type CreateOSD struct {
+	XMLName string                 `xml:"tr2:CreateOSD"`
+	OSD     onvif.OSDConfiguration `xml:"tr2:OSD"`
+}
+
+// CreateOSDResponse is the reply to the CreateOSD operation.
+type CreateOSDResponse struct {
+	OSDToken onvif.ReferenceToken `xml:"OSDToken"`
+}
+
+// DeleteOSD is the request of the DeleteOSD operation. Delete the OSD.
+type DeleteOSD struct {
+	XMLName  string               `xml:"tr2:DeleteOSD"`
+	OSDToken onvif.ReferenceToken `xml:"tr2:OSDToken"`
+}
+
+// DeleteOSDResponse is the reply to the DeleteOSD operation.
+type DeleteOSDResponse struct {
+	SetConfigurationResponse
+}
+
+// GetMasks is the request of the GetMasks operation. This operation lists existing Mask
+// configurations for the device.
+type GetMasks struct {
+	XMLName            string               `xml:"tr2:GetMasks"`
+	Token              onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+}
+
+// GetMasksResponse is the reply to the GetMasks operation.
+type GetMasksResponse struct {
+	Masks []Mask `xml:"Masks"`
+}
+
+// GetMaskOptions is the request of the GetMaskOptions operation. Get the Mask Options.
+type GetMaskOptions struct {
+	XMLName            string               `xml:"tr2:GetMaskOptions"`
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+}
+
+// GetMaskOptionsResponse is the reply to the GetMaskOptions operation.
+type GetMaskOptionsResponse struct {
+	Options MaskOptions `xml:"Options"`
+}
+
+// SetMask is the request of the SetMask operation. Set the Mask
+type SetMask struct {
+	XMLName string `xml:"tr2:SetMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+// SetMaskResponse is the reply to the SetMask operation.
+type SetMaskResponse struct {
+	SetConfigurationResponse
+}
+
+// CreateMask is the request of the CreateMask operation. Create the Mask.
+type CreateMask struct {
+	XMLName string `xml:"tr2:CreateMask"`
+	Mask    Mask   `xml:"tr2:Mask"`
+}
+
+// CreateMaskResponse is the reply to the CreateMask operation.
+type CreateMaskResponse struct {
+	Token onvif.ReferenceToken `xml:"Token"`
+}
+
+// DeleteMask is the request of the DeleteMask operation. Delete the Mask.
+type DeleteMask struct {
+	XMLName string               `xml:"tr2:DeleteMask"`
+	Token   onvif.ReferenceToken `xml:"tr2:Token"`
+}
+
+// DeleteMaskResponse is the reply to the DeleteMask operation.
+type DeleteMaskResponse struct {
+	SetConfigurationResponse
+}
+
+// Capabilities2 type.
+type Capabilities2 struct {
+	SnapshotUri           xsd.Boolean           `xml:"SnapshotUri,attr"`
+	Rotation              xsd.Boolean           `xml:"Rotation,attr"`
+	VideoSourceMode       xsd.Boolean           `xml:"VideoSourceMode,attr"`
+	OSD                   xsd.Boolean           `xml:"OSD,attr"`
+	TemporaryOSDText      xsd.Boolean           `xml:"TemporaryOSDText,attr"`
+	Mask                  xsd.Boolean           `xml:"Mask,attr"`
+	SourceMask            xsd.Boolean           `xml:"SourceMask,attr"`
+	ProfileCapabilities   ProfileCapabilities   `xml:"ProfileCapabilities"`
+	StreamingCapabilities StreamingCapabilities `xml:"StreamingCapabilities"`
+}
+
+// ProfileCapabilities type.
+type ProfileCapabilities struct {
+	MaximumNumberOfProfiles xsd.Int           `xml:"MaximumNumberOfProfiles,attr"`
+	ConfigurationsSupported xsd.AnySimpleType `xml:"ConfigurationsSupported,attr"`
+}
+
+// StreamingCapabilities type.
+type StreamingCapabilities struct {
+	RTSPStreaming       xsd.Boolean `xml:"RTSPStreaming,attr"`
+	RTPMulticast        xsd.Boolean `xml:"RTPMulticast,attr"`
+	RTP_RTSP_TCP        xsd.Boolean `xml:"RTP_RTSP_TCP,attr"`
+	NonAggregateControl xsd.Boolean `xml:"NonAggregateControl,attr"`
+	RTSPWebSocketUri    xsd.AnyURI  `xml:"RTSPWebSocketUri,attr"`
+	AutoStartMulticast  xsd.Boolean `xml:"AutoStartMulticast,attr"`
+}
+
+// ConfigurationEnumeration type.
+type ConfigurationEnumeration xsd.String
+
+// Values of ConfigurationEnumeration
+const (
+	ConfigurationEnumerationAll          ConfigurationEnumeration = "All"
+	ConfigurationEnumerationVideoSource  ConfigurationEnumeration = "VideoSource"
+	ConfigurationEnumerationVideoEncoder ConfigurationEnumeration = "VideoEncoder"
+	ConfigurationEnumerationAudioSource  ConfigurationEnumeration = "AudioSource"
+	ConfigurationEnumerationAudioEncoder ConfigurationEnumeration = "AudioEncoder"
+	ConfigurationEnumerationAudioOutput  ConfigurationEnumeration = "AudioOutput"
+	ConfigurationEnumerationAudioDecoder ConfigurationEnumeration = "AudioDecoder"
+	ConfigurationEnumerationMetadata     ConfigurationEnumeration = "Metadata"
+	ConfigurationEnumerationAnalytics    ConfigurationEnumeration = "Analytics"
+	ConfigurationEnumerationPTZ          ConfigurationEnumeration = "PTZ"
+)
+
+// ConfigurationRef type.
+type ConfigurationRef struct {
+	Type  xsd.String           `xml:"tr2:Type"`
+	Token onvif.ReferenceToken `xml:"tr2:Token,omitempty"`
+}
+
+// ConfigurationSet type. A set of media configurations.
+type ConfigurationSet struct {
+	VideoSource  onvif.VideoSourceConfiguration    `xml:"VideoSource"`
+	AudioSource  onvif.AudioSourceConfiguration    `xml:"AudioSource"`
+	VideoEncoder onvif.VideoEncoder2Configuration  `xml:"VideoEncoder"`
+	AudioEncoder onvif.AudioEncoder2Configuration  `xml:"AudioEncoder"`
+	Analytics    onvif.VideoAnalyticsConfiguration `xml:"Analytics"`
+	PTZ          onvif.PTZConfiguration            `xml:"PTZ"`
+	Metadata     onvif.MetadataConfiguration       `xml:"Metadata"`
+	AudioOutput  onvif.AudioOutputConfiguration    `xml:"AudioOutput"`
+	AudioDecoder onvif.AudioDecoderConfiguration   `xml:"AudioDecoder"`
+}
+
+// MediaProfile type. A media profile consists of a set of media configurations.
+type MediaProfile struct {
+	Token          onvif.ReferenceToken `xml:"token,attr"`
+	Fixed          xsd.Boolean          `xml:"fixed,attr"`
+	Name           onvif.Name           `xml:"Name"`
+	Configurations ConfigurationSet     `xml:"Configurations"`
+}
+
+// GetConfiguration type.
+type GetConfiguration struct {
+	ConfigurationToken onvif.ReferenceToken `xml:"tr2:ConfigurationToken,omitempty"`
+	ProfileToken       onvif.ReferenceToken `xml:"tr2:ProfileToken,omitempty"`
+}
+
+// SetConfigurationResponse type.
+type SetConfigurationResponse struct {
+}
+
+// TransportProtocol type.
+type TransportProtocol xsd.String
+
+// Values of TransportProtocol
+const (
+	TransportProtocolRtspUnicast   TransportProtocol = "RtspUnicast"
+	TransportProtocolRtspMulticast TransportProtocol = "RtspMulticast"
+	TransportProtocolRTSP          TransportProtocol = "RTSP"
+	TransportProtocolRtspOverHttp  TransportProtocol = "RtspOverHttp"
+)
+
+// EncoderInstance type.
+type EncoderInstance struct {
+	Encoding xsd.String `xml:"Encoding"`
+	Number   xsd.Int    `xml:"Number"`
+}
+
+// EncoderInstanceInfo type.
+type EncoderInstanceInfo struct {
+	Codec []EncoderInstance `xml:"Codec"`
+	Total xsd.Int           `xml:"Total"`
+}
+
+// StartStopMulticastStreaming type.
+type StartStopMulticastStreaming struct {
+	ProfileToken onvif.ReferenceToken `xml:"tr2:ProfileToken"`
+}
+
+// EncodingTypes type. Indication which encodings are supported for this video source. The list may
+// contain one or more enumeration values of tt:VideoEncoding.
+type EncodingTypes xsd.AnySimpleType
+
+// VideoSourceMode type.
+type VideoSourceMode struct {
+	Token         onvif.ReferenceToken  `xml:"token,attr"`
+	Enabled       xsd.Boolean           `xml:"Enabled,attr"`
+	MaxFramerate  xsd.Float             `xml:"MaxFramerate"`
+	MaxResolution onvif.VideoResolution `xml:"MaxResolution"`
+	Encodings     EncodingTypes         `xml:"Encodings"`
+	Reboot        xsd.Boolean           `xml:"Reboot"`
+	Description   onvif.Description     `xml:"Description"`
+}
+
+// MaskType type.
+type MaskType xsd.String
+
+// Values of MaskType
+const (
+	MaskTypeColor     MaskType = "Color"
+	MaskTypePixelated MaskType = "Pixelated"
+	MaskTypeBlurred   MaskType = "Blurred"
+)
+
+// Mask type.
+type Mask struct {
+	Token                    onvif.ReferenceToken `xml:"token,attr,omitempty"`
+	VideoSourceConfiguration onvif.ReferenceToken `xml:"VideoSourceConfiguration,attr,omitempty"`
+	ConfigurationToken       onvif.ReferenceToken `xml:"tr2:ConfigurationToken"`
+	Polygon                  onvif.Polygon        `xml:"tr2:Polygon"`
+	Type                     xsd.String           `xml:"tr2:Type"`
+	Color                    *onvif.Color         `xml:"tr2:Color"`
+	Enabled                  xsd.Boolean          `xml:"tr2:Enabled"`
+}
+
+// UnmarshalXML decodes a Mask, whatever the prefixes used by the device
+func (v *Mask) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Mask
+	return onvif.DecodeQualified(d, start, (*plain)(v), "http://www.onvif.org/ver20/media/wsdl", "tr2")
+}
+
+// MaskOptions type.
+type MaskOptions struct {
+	RectangleOnly   xsd.Boolean        `xml:"RectangleOnly,attr"`
+	SingleColorOnly xsd.Boolean        `xml:"SingleColorOnly,attr"`
+	MaxMasks        xsd.Int            `xml:"MaxMasks"`
+	MaxPoints       xsd.Int            `xml:"MaxPoints"`
+	Types           []xsd.String       `xml:"Types"`
+	Color           onvif.ColorOptions `xml:"Color"`
+}
@@ -0,0 +1,202 @@
+package networking
+
+import (
+	"crypto/md5"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"unicode/utf8"
+
+	"github.com/juju/errors"
+)
+
+// DigestChallenge is a parsed "WWW-Authenticate: Digest" challenge (RFC 2617, RFC 7616).
+// A challenge may be reused for several requests, each one with its own nonce count.
+type DigestChallenge struct {
+	Realm     string
+	Nonce     string
+	Opaque    string
+	Algorithm string
+	Qop       []string
+	Stale     bool
+
+	nc uint32
+}
+
+// FindDigestChallenge returns the Digest challenge of the WWW-Authenticate headers in h.
+// When several are offered, SHA-256 is preferred over MD5. It returns nil if
+// the server does not propose any Digest challenge.
+func FindDigestChallenge(h http.Header) *DigestChallenge {
+	var best *DigestChallenge
+	for _, value := range h.Values("WWW-Authenticate") {
+		c, err := ParseDigestChallenge(value)
+		if err != nil {
+			continue
+		}
+		if best == nil || (!best.isSHA256() && c.isSHA256()) {
+			best = c
+		}
+	}
+	return best
+}
+
+// ParseDigestChallenge parses the value of a WWW-Authenticate header
+func ParseDigestChallenge(header string) (*DigestChallenge, error) {
+	scheme, params, _ := strings.Cut(strings.TrimSpace(header), " ")
+	if !strings.EqualFold(scheme, "Digest") {
+		return nil, errors.NotSupportedf("authentication scheme %q", scheme)
+	}
+
+	c := new(DigestChallenge)
+	for key, value := range parseAuthParams(params) {
+		switch strings.ToLower(key) {
+		case "realm":
+			c.Realm = value
+		case "nonce":
+			c.Nonce = value
+		case "opaque":
+			c.Opaque = value
+		case "algorithm":
+			c.Algorithm = value
+		case "stale":
+			c.Stale = strings.EqualFold(value, "true")
+		case "qop":
+			for _, q := range strings.Split(value, ",") {
+				c.Qop = append(c.Qop, strings.TrimSpace(q))
+			}
+		}
+	}
+
+	if c.Nonce == "" {
+		return nil, errors.NotValidf("digest challenge without nonce")
+	}
+	switch strings.ToUpper(c.Algorithm) {
+	case "", "MD5", "MD5-SESS", "SHA-256", "SHA-256-SESS":
+	default:
+		return nil, errors.NotSupportedf("digest algorithm %q", c.Algorithm)
+	}
+	return c, nil
+}
+
+// Authorization computes the value of the Authorization header answering the
+// challenge for a request with the given method and request URI.
+func (c *DigestChallenge) Authorization(method, uri, username, password string) string {
+	algorithm := strings.ToUpper(c.Algorithm)
+	newHash := md5.New
+	if strings.HasPrefix(algorithm, "SHA-256") {
+		newHash = sha256.New
+	}
+	h := func(s string) string { return hashHex(newHash(), s) }
+
+	cnonce := newCnonce()
+	ha1 := h(username + ":" + c.Realm + ":" + password)
+	if strings.HasSuffix(algorithm, "-SESS") {
+		ha1 = h(ha1 + ":" + c.Nonce + ":" + cnonce)
+	}
+	ha2 := h(method + ":" + uri)
+
+	var b strings.Builder
+	if quotable(username) {
+		fmt.Fprintf(&b, `Digest username=%s`, quote(username))
+	} else {
+		// RFC 7616 3.4.4, a username that cannot be a quoted-string
+		fmt.Fprintf(&b, `Digest username*=UTF-8''%s`, url.PathEscape(username))
+	}
+	fmt.Fprintf(&b, `, realm=%s, nonce=%s, uri=%s`, quote(c.Realm), quote(c.Nonce), quote(uri))
+	if c.supportsQopAuth() {
+		nc := fmt.Sprintf("%08x", atomic.AddUint32(&c.nc, 1))
+		response := h(ha1 + ":" + c.Nonce + ":" + nc + ":" + cnonce + ":auth:" + ha2)
+		fmt.Fprintf(&b, `, qop=auth, nc=%s, cnonce="%s", response="%s"`, nc, cnonce, response)
+	} else {
+		// RFC 2069 compatibility, no qop advertised by the server
+		fmt.Fprintf(&b, `, response="%s"`, h(ha1+":"+c.Nonce+":"+ha2))
+	}
+	if c.Algorithm != "" {
+		fmt.Fprintf(&b, `, algorithm=%s`, c.Algorithm)
+	}
+	if c.Opaque != "" {
+		fmt.Fprintf(&b, `, opaque=%s`, quote(c.Opaque))
+	}
+	return b.String()
+}
+
+func (c *DigestChallenge) isSHA256() bool {
+	return strings.HasPrefix(strings.ToUpper(c.Algorithm), "SHA-256")
+}
+
+func (c *DigestChallenge) supportsQopAuth() bool {
+	for _, q := range c.Qop {
+		if strings.EqualFold(q, "auth") {
+			return true
+		}
+	}
+	return false
+}
+
+// quote returns s as a quoted-string, its quotes and backslashes escaped
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// quotable tells if s holds only printable ASCII characters
+func quotable(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf || s[i] < ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+func hashHex(h hash.Hash, s string) string {
+	h.Write([]byte(s))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func newCnonce() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// parseAuthParams splits a comma separated list of key=value pairs, the values
+// being possibly quoted strings that contain commas.
+func parseAuthParams(s string) map[string]string {
+	params := make(map[string]string)
+	for s = strings.TrimSpace(s); s != ""; s = strings.TrimSpace(s) {
+		key, rest, ok := strings.Cut(s, "=")
+		if !ok {
+			break
+		}
+		key = strings.TrimSpace(key)
+		rest = strings.TrimSpace(rest)
+
+		var value string
+		if strings.HasPrefix(rest, `"`) {
+			var b strings.Builder
+			i := 1
+			for ; i < len(rest) && rest[i] != '"'; i++ {
+				if rest[i] == '\\' && i+1 < len(rest) {
+					i++
+				}
+				b.WriteByte(rest[i])
+			}
+			value = b.String()
+			rest = rest[min(i+1, len(rest)):]
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+			value = strings.TrimSpace(value)
+			rest = "," + rest
+		}
+		params[key] = value
+
+		_, s, _ = strings.Cut(rest, ",")
+	}
+	return params
+}
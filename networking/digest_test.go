@@ -0,0 +1,39 @@
+package networking
+
+import (
+	"crypto/md5"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigestAuthorizationEscaping(t *testing.T) {
+	c, err := ParseDigestChallenge(`Digest realm="a \"quoted\" realm", nonce="abc", qop="auth"`)
+	require.NoError(t, err)
+	assert.Equal(t, `a "quoted" realm`, c.Realm)
+
+	header := c.Authorization("POST", "/onvif/device_service", `ad"min\`, "secret")
+	scheme, params, _ := strings.Cut(header, " ")
+	assert.Equal(t, "Digest", scheme)
+	got := parseAuthParams(params)
+	assert.Equal(t, `ad"min\`, got["username"])
+	assert.Equal(t, c.Realm, got["realm"])
+	assert.Equal(t, "/onvif/device_service", got["uri"])
+
+	// The response is computed on the unescaped values
+	ha1 := hashHex(md5.New(), `ad"min\:`+c.Realm+":secret")
+	ha2 := hashHex(md5.New(), "POST:/onvif/device_service")
+	want := hashHex(md5.New(), ha1+":abc:"+got["nc"]+":"+got["cnonce"]+":auth:"+ha2)
+	assert.Equal(t, want, got["response"])
+}
+
+func TestDigestAuthorizationNonASCIIUsername(t *testing.T) {
+	c, err := ParseDigestChallenge(`Digest realm="camera", nonce="abc"`)
+	require.NoError(t, err)
+
+	header := c.Authorization("POST", "/onvif/device_service", "opérateur", "secret")
+	assert.Contains(t, header, `username*=UTF-8''op%C3%A9rateur`)
+	assert.NotContains(t, header, `username="`)
+}
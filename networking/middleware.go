@@ -0,0 +1,87 @@
+package networking
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/rs/zerolog"
+	"golang.org/x/time/rate"
+)
+
+// Observation describes a completed round trip, for metrics purposes
+type Observation struct {
+	Endpoint   string
+	Operation  string
+	StatusCode int
+	Duration   time.Duration
+	Err        error
+}
+
+// Observe calls observe after each round trip, e.g. to feed metrics
+func Observe(observe func(Observation)) Middleware {
+	return func(next Transport) Transport {
+		return TransportFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(ctx, req)
+			o := Observation{
+				Endpoint:  req.Endpoint,
+				Operation: req.Operation,
+				Duration:  time.Since(start),
+				Err:       err,
+			}
+			if resp != nil {
+				o.StatusCode = resp.StatusCode
+			}
+			observe(o)
+			return resp, err
+		})
+	}
+}
+
+// Logging logs each round trip on logger, at the debug level or at the
+// warning level for the failed ones
+func Logging(logger zerolog.Logger) Middleware {
+	return Observe(func(o Observation) {
+		event := logger.Debug()
+		if o.Err != nil || o.StatusCode >= 400 {
+			event = logger.Warn().Err(o.Err)
+		}
+		event.
+			Str("endpoint", o.Endpoint).
+			Str("action", o.Operation).
+			Int("status", o.StatusCode).
+			Dur("duration", o.Duration).
+			Msg("SOAP")
+	})
+}
+
+// Throttle delays the round trips so that they respect limiter. Each device
+// being built with its own middlewares, a limiter per device throttles the devices
+// independently.
+func Throttle(limiter *rate.Limiter) Middleware {
+	return func(next Transport) Transport {
+		return TransportFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			if err := limiter.Wait(ctx); err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					return nil, ctxErr
+				}
+				return nil, err
+			}
+			return next.RoundTrip(ctx, req)
+		})
+	}
+}
+
+// FaultInjection lets inject replace the round trips, for test purposes.
+// When inject returns neither a reply nor an error, the request goes through.
+func FaultInjection(inject func(ctx context.Context, req *Request) (*http.Response, error)) Middleware {
+	return func(next Transport) Transport {
+		return TransportFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			if resp, err := inject(ctx, req); resp != nil || err != nil {
+				return resp, err
+			}
+			return next.RoundTrip(ctx, req)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package networking
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+// recorder returns a middleware appending name to calls when a request goes through it
+func recorder(name string, calls *[]string) Middleware {
+	return func(next Transport) Transport {
+		return TransportFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+			*calls = append(*calls, name)
+			return next.RoundTrip(ctx, req)
+		})
+	}
+}
+
+func okTransport(calls *[]string) Transport {
+	return TransportFunc(func(ctx context.Context, req *Request) (*http.Response, error) {
+		*calls = append(*calls, "transport")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("ok"))}, nil
+	})
+}
+
+func TestChainOrder(t *testing.T) {
+	var calls []string
+	transport := Chain(okTransport(&calls), recorder("outer", &calls), recorder("inner", &calls))
+
+	resp, err := transport.RoundTrip(context.Background(), &Request{Operation: "GetProfiles"})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"outer", "inner", "transport"}, calls)
+}
+
+func TestObserve(t *testing.T) {
+	var calls []string
+	var observed []Observation
+	transport := Chain(okTransport(&calls), Observe(func(o Observation) {
+		observed = append(observed, o)
+	}))
+
+	resp, err := transport.RoundTrip(context.Background(), &Request{Endpoint: "http://camera/onvif/media", Operation: "GetProfiles"})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	require.Len(t, observed, 1)
+	assert.Equal(t, "http://camera/onvif/media", observed[0].Endpoint)
+	assert.Equal(t, "GetProfiles", observed[0].Operation)
+	assert.Equal(t, http.StatusOK, observed[0].StatusCode)
+	assert.NoError(t, observed[0].Err)
+}
+
+func TestFaultInjection(t *testing.T) {
+	var calls []string
+	injected := errors.New("connection reset")
+	transport := Chain(okTransport(&calls), FaultInjection(func(ctx context.Context, req *Request) (*http.Response, error) {
+		if req.Operation == "GetStatus" {
+			return nil, injected
+		}
+		return nil, nil
+	}))
+
+	_, err := transport.RoundTrip(context.Background(), &Request{Operation: "GetStatus"})
+	assert.ErrorIs(t, err, injected)
+	assert.Empty(t, calls)
+
+	resp, err := transport.RoundTrip(context.Background(), &Request{Operation: "GetProfiles"})
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, []string{"transport"}, calls)
+}
+
+func TestThrottle(t *testing.T) {
+	var calls []string
+	transport := Chain(okTransport(&calls), Throttle(rate.NewLimiter(rate.Every(time.Hour), 1)))
+
+	resp, err := transport.RoundTrip(context.Background(), &Request{})
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The next token comes in an hour, beyond the deadline
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = transport.RoundTrip(ctx, &Request{})
+	assert.Error(t, err)
+	assert.Len(t, calls, 1)
+}
+
+func TestHTTPTransport(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("X-Content-Type", r.Header.Get("Content-Type"))
+		w.Header().Set("X-Custom", r.Header.Get("X-Custom"))
+		w.Write(body)
+	}))
+	defer srv.Close()
+
+	transport := NewHTTPTransport(srv.Client())
+	resp, err := transport.RoundTrip(context.Background(), &Request{
+		Endpoint: srv.URL,
+		Envelope: "<Envelope/>",
+		Header:   http.Header{"X-Custom": {"1"}},
+	})
+	require.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "<Envelope/>", string(body))
+	assert.Equal(t, "application/soap+xml; charset=utf-8", resp.Header.Get("X-Content-Type"))
+	assert.Equal(t, "1", resp.Header.Get("X-Custom"))
+
+	// The Body replaces the Envelope
+	resp, err = transport.RoundTrip(context.Background(), &Request{
+		Endpoint: srv.URL,
+		Envelope: "<Envelope/>",
+		Body:     strings.NewReader("streamed"),
+		Header:   http.Header{"Content-Type": {"multipart/related"}},
+	})
+	require.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "streamed", string(body))
+	assert.Equal(t, "multipart/related", resp.Header.Get("X-Content-Type"))
+}
@@ -2,6 +2,8 @@ package networking
 
 import (
 	"bytes"
+	"context"
+	"io"
 	"net/http"
 
 	"github.com/juju/errors"
@@ -9,8 +11,41 @@ import (
 
 // SendSoap send soap message
 func SendSoap(httpClient *http.Client, endpoint, message string) (*http.Response, error) {
-	resp, err := httpClient.Post(endpoint, "application/soap+xml; charset=utf-8", bytes.NewBufferString(message))
+	return SendSoapWithContext(context.Background(), httpClient, endpoint, message)
+}
+
+// SendSoapWithContext send soap message bound to ctx. The request and the
+// reading of the reply body are aborted as soon as ctx is done, in which case
+// the error of the context (e.g. context.DeadlineExceeded) is returned.
+func SendSoapWithContext(ctx context.Context, httpClient *http.Client, endpoint, message string) (*http.Response, error) {
+	return SendSoapWithHeader(ctx, httpClient, endpoint, message, nil)
+}
+
+// SendSoapWithHeader send soap message bound to ctx, with additional HTTP headers
+// (e.g. an Authorization header). The Content-Type defaults to the one of SOAP 1.2.
+func SendSoapWithHeader(ctx context.Context, httpClient *http.Client, endpoint, message string, header http.Header) (*http.Response, error) {
+	return SendSoapBody(ctx, httpClient, endpoint, bytes.NewBufferString(message), header)
+}
+
+// SendSoapBody send the soap message streamed by body, bound to ctx, with
+// additional HTTP headers (e.g. the Content-Type of an MTOM message).
+func SendSoapBody(ctx context.Context, httpClient *http.Client, endpoint string, body io.Reader, header http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return nil, errors.Annotate(err, "NewRequest")
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/soap+xml; charset=utf-8")
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return resp, errors.Annotate(err, "Post")
 	}
 
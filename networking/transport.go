@@ -0,0 +1,70 @@
+package networking
+
+import (
+	"context"
+	"io"
+	"net/http"
+)
+
+// Request is a SOAP request to send to an endpoint of a device
+type Request struct {
+	// Endpoint is the URL of the service
+	Endpoint string
+	// Operation is the name of the ONVIF operation, e.g. "GetProfiles"
+	Operation string
+	// Envelope is the SOAP envelope to send
+	Envelope string
+	// Body, when set, is sent instead of the Envelope, e.g. an MTOM message
+	// embedding the Envelope. It can be read only once.
+	Body io.Reader
+	// Header holds additional HTTP headers, e.g. Authorization
+	Header http.Header
+}
+
+// Transport sends SOAP envelopes to a device and returns its replies.
+// The body of the reply holds the response envelope and must be closed by the caller.
+type Transport interface {
+	RoundTrip(ctx context.Context, req *Request) (*http.Response, error)
+}
+
+// TransportFunc adapts an ordinary function to a Transport
+type TransportFunc func(ctx context.Context, req *Request) (*http.Response, error)
+
+// RoundTrip calls f(ctx, req)
+func (f TransportFunc) RoundTrip(ctx context.Context, req *Request) (*http.Response, error) {
+	return f(ctx, req)
+}
+
+// Middleware decorates a Transport with a cross-cutting behavior (logging,
+// metrics, throttling, ...)
+type Middleware func(next Transport) Transport
+
+// Chain decorates t with the middlewares, the first one being the outermost
+func Chain(t Transport, middlewares ...Middleware) Transport {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		t = middlewares[i](t)
+	}
+	return t
+}
+
+// HTTPTransport is the Transport sending the envelopes with an http.Client
+type HTTPTransport struct {
+	Client *http.Client
+}
+
+// NewHTTPTransport returns a Transport sending the envelopes with client,
+// or with a default client if nil
+func NewHTTPTransport(client *http.Client) *HTTPTransport {
+	if client == nil {
+		client = new(http.Client)
+	}
+	return &HTTPTransport{Client: client}
+}
+
+// RoundTrip posts the envelope, or the body, to the endpoint
+func (t *HTTPTransport) RoundTrip(ctx context.Context, req *Request) (*http.Response, error) {
+	if req.Body != nil {
+		return SendSoapBody(ctx, t.Client, req.Endpoint, req.Body, req.Header)
+	}
+	return SendSoapWithHeader(ctx, t.Client, req.Endpoint, req.Envelope, req.Header)
+}
@@ -1,16 +1,19 @@
 package onvif
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
-	"io/ioutil"
+	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"reflect"
 	"strconv"
 	"strings"
+	"time"
 
-	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/event"
 	"github.com/BalkarSandhu/go-onvif/gosoap"
 	"github.com/BalkarSandhu/go-onvif/networking"
 	wsdiscovery "github.com/BalkarSandhu/go-onvif/ws-discovery"
@@ -21,12 +24,15 @@ import (
 // Xlmns XML Scheam
 var Xlmns = map[string]string{
 	"onvif":   "http://www.onvif.org/ver10/schema",
+	"tt":      "http://www.onvif.org/ver10/schema", // prefix of the QNames sent back, e.g. tt:CellMotionEngine
 	"tds":     "http://www.onvif.org/ver10/device/wsdl",
 	"trt":     "http://www.onvif.org/ver10/media/wsdl",
+	"tr2":     "http://www.onvif.org/ver20/media/wsdl",
 	"tev":     "http://www.onvif.org/ver10/events/wsdl",
 	"tptz":    "http://www.onvif.org/ver20/ptz/wsdl",
 	"timg":    "http://www.onvif.org/ver20/imaging/wsdl",
 	"tan":     "http://www.onvif.org/ver20/analytics/wsdl",
+	"trc":     "http://www.onvif.org/ver10/recording/wsdl",
 	"xmime":   "http://www.w3.org/2005/05/xmlmime",
 	"wsnt":    "http://docs.oasis-open.org/wsn/b-2",
 	"xop":     "http://www.w3.org/2004/08/xop/include",
@@ -71,6 +77,8 @@ type DeviceInfo struct {
 	FirmwareVersion string
 	SerialNumber    string
 	HardwareId      string
+	// EndpointReference is the GUID of the device, empty when it did not tell it
+	EndpointReference string
 }
 
 // Device for a new device of onvif and DeviceInfo
@@ -78,25 +86,68 @@ type DeviceInfo struct {
 // It contains methods, which helps to communicate with ONVIF device
 type Device struct {
 	params    DeviceParams
-	endpoints map[string]string
-	info      DeviceInfo
+	xaddr     *url.URL
+	dir       *directory
+	transport networking.Transport
+	limiter   *limiter
+	auth      *authState
+	soap      *soapState
+	clock     *clockState
 }
 
 type DeviceParams struct {
+	// Xaddr is either the host[:port] of the device, or the URL of its device
+	// service (e.g. https://192.168.1.10:8443/onvif/device_service)
 	Xaddr      string
 	Username   string
 	Password   string
 	HttpClient *http.Client
+	// AuthMode selects how Username and Password are presented to the device,
+	// WS-Security by default
+	AuthMode AuthMode
+	// SOAPVersion selects the version of the envelopes, SOAP 1.2 by default
+	SOAPVersion SOAPVersion
+	// TLS configures the verification of the HTTPS endpoints of the device
+	TLS *TLSConfig
+	// RequireTLS refuses to send the credentials to the endpoints that are not HTTPS
+	RequireTLS bool
+	// Transport sends the SOAP envelopes to the device, over HttpClient by
+	// default. A custom Transport carries its own TLS configuration, TLS must be
+	// nil then. RequireTLS still applies, to the scheme of the endpoints.
+	Transport networking.Transport
+	// Middlewares decorate the Transport, the first one being the outermost
+	Middlewares []networking.Middleware
+	// Retry retries the read-only operations failing at the transport level.
+	// Nothing is retried when nil, see DefaultRetryPolicy and WithRetryPolicy.
+	Retry *RetryPolicy
+	// Concurrency bounds the requests sent at once to the device, by all the
+	// copies of the Device. The requests are not limited when nil.
+	Concurrency *ConcurrencyLimit
+	// Trace is called after each SOAP exchange with the device, e.g. JSONLTrace
+	Trace func(TraceEvent)
+	// MaxResponseSize bounds the size of the replies, DefaultMaxResponseSize
+	// when 0. The replies are not bounded when negative. The attachments of
+	// the MTOM replies decoded by the sdk package are streamed, only the parts
+	// it holds in memory are bounded then, see WithStreamedAttachments.
+	MaxResponseSize int64
 }
 
 // GetServices return available endpoints
 func (dev *Device) GetServices() map[string]string {
-	return dev.endpoints
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
+	endpoints := make(map[string]string, len(dev.dir.endpoints))
+	for k, v := range dev.dir.endpoints {
+		endpoints[k] = v
+	}
+	return endpoints
 }
 
 // GetDeviceInfo return available endpoints
 func (dev *Device) GetDeviceInfo() DeviceInfo {
-	return dev.info
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
+	return dev.dir.info
 }
 
 // GetDeviceParams return available endpoints
@@ -104,14 +155,6 @@ func (dev *Device) GetDeviceParams() DeviceParams {
 	return dev.params
 }
 
-func readResponse(resp *http.Response) string {
-	b, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		panic(err)
-	}
-	return string(b)
-}
-
 // GetAvailableDevicesAtSpecificEthernetInterface ...
 func GetAvailableDevicesAtSpecificEthernetInterface(interfaceName string) ([]Device, error) {
 	// Call a ws-discovery Probe Message to Discover NVT type Devices
@@ -149,13 +192,12 @@ func GetAvailableDevicesAtSpecificEthernetInterface(interfaceName string) ([]Dev
 func (dev *Device) getSupportedServices(resp *http.Response) error {
 	doc := etree.NewDocument()
 
-	data, err := ioutil.ReadAll(resp.Body)
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
 	if err != nil {
 		return err
 	}
 
-	resp.Body.Close()
-
 	if err := doc.ReadFromBytes(data); err != nil {
 		//log.Println(err.Error())
 		return err
@@ -164,11 +206,13 @@ func (dev *Device) getSupportedServices(resp *http.Response) error {
 	services := doc.FindElements("./Envelope/Body/GetCapabilitiesResponse/Capabilities/*/XAddr")
 	for _, j := range services {
 		dev.addEndpoint(j.Parent().Tag, j.Text())
+		dev.addCapabilityService(j.Parent().Tag)
 	}
 
 	extension_services := doc.FindElements("./Envelope/Body/GetCapabilitiesResponse/Capabilities/Extension/*/XAddr")
 	for _, j := range extension_services {
 		dev.addEndpoint(j.Parent().Tag, j.Text())
+		dev.addCapabilityService(j.Parent().Tag)
 	}
 
 	return nil
@@ -176,26 +220,62 @@ func (dev *Device) getSupportedServices(resp *http.Response) error {
 
 // NewDevice function construct a ONVIF Device entity
 func NewDevice(params DeviceParams) (*Device, error) {
-	dev := new(Device)
-	dev.params = params
-	dev.endpoints = make(map[string]string)
-	dev.addEndpoint("Device", "http://"+dev.params.Xaddr+"/onvif/device_service")
+	return NewDeviceContext(context.Background(), params)
+}
 
-	if dev.params.HttpClient == nil {
-		dev.params.HttpClient = new(http.Client)
+// NewDeviceContext function construct a ONVIF Device entity, the discovery
+// of the services of the device is bound to ctx.
+func NewDeviceContext(ctx context.Context, params DeviceParams) (*Device, error) {
+	dev, err := NewDeviceOffline(params)
+	if err != nil {
+		return nil, err
 	}
+	if err := dev.Connect(ctx); err != nil {
+		return nil, err
+	}
+	return dev, nil
+}
 
-	getCapabilities := device.GetCapabilities{Category: "All"}
+// NewDeviceOffline constructs a ONVIF Device entity without network I/O. Only
+// the device service is known until Connect discovers the other ones.
+func NewDeviceOffline(params DeviceParams) (*Device, error) {
+	dev := new(Device)
+	dev.params = params
+	dev.dir = newDirectory()
+	dev.auth = new(authState)
+	dev.soap = new(soapState)
+	dev.clock = new(clockState)
 
-	resp, err := dev.CallMethod(getCapabilities)
-	if err != nil || resp.StatusCode != http.StatusOK {
-		return nil, errors.New("camera is not available at " + dev.params.Xaddr + " or it does not support ONVIF services")
+	xaddr, err := parseXaddr(params.Xaddr)
+	if err != nil {
+		return nil, err
 	}
+	dev.xaddr = xaddr
+	dev.addEndpoint("Device", xaddr.String())
 
-	err = dev.getSupportedServices(resp)
-	if err != nil {
+	if params.Transport != nil && params.TLS != nil {
+		return nil, ErrTLSWithTransport
+	}
+	if dev.params.HttpClient, err = params.httpClient(); err != nil {
 		return nil, err
 	}
+	dev.transport = params.Transport
+	if dev.transport == nil {
+		dev.transport = networking.NewHTTPTransport(dev.params.HttpClient)
+	}
+	// Each attempt goes through the middlewares, then waits for its turn
+	middlewares := append([]networking.Middleware{retrying(params.Retry)}, params.Middlewares...)
+	if params.Concurrency != nil {
+		dev.limiter = newLimiter(*params.Concurrency)
+		middlewares = append(middlewares, dev.limiter.middleware)
+	}
+	if params.Trace != nil {
+		middlewares = append(middlewares, tracing(params.Trace))
+	}
+	if max := dev.MaxResponseSize(); max >= 0 {
+		middlewares = append(middlewares, boundingReplies(max))
+	}
+	dev.transport = networking.Chain(dev.transport, middlewares...)
 
 	return dev, nil
 }
@@ -204,41 +284,77 @@ func (dev *Device) addEndpoint(Key, Value string) {
 	//use lowCaseKey
 	//make key having ability to handle Mixed Case for Different vendor devcie (e.g. Events EVENTS, events)
 	lowCaseKey := strings.ToLower(Key)
+	Value = dev.reachable(Value)
 
-	// Replace host with host from device params.
-	if u, err := url.Parse(Value); err == nil {
-		u.Host = dev.params.Xaddr
+	dev.dir.Lock()
+	dev.dir.endpoints[lowCaseKey] = Value
+	dev.dir.Unlock()
+}
+
+// reachable replaces the host of the address advertised by the device with
+// the host the device is reached at: the advertised one may be a private
+// address behind a NAT.
+func (dev *Device) reachable(Value string) string {
+	if u, err := url.Parse(Value); err == nil && dev.xaddr != nil {
+		if u.Scheme == "" || u.Scheme == dev.xaddr.Scheme {
+			u.Scheme = dev.xaddr.Scheme
+			u.Host = dev.xaddr.Host
+		} else if port := u.Port(); port != "" {
+			// Another scheme is served on another port, keep it
+			u.Host = net.JoinHostPort(dev.xaddr.Hostname(), port)
+		} else {
+			u.Host = dev.xaddr.Hostname()
+			if strings.Contains(u.Host, ":") {
+				u.Host = "[" + u.Host + "]"
+			}
+		}
 		Value = u.String()
 	}
+	return Value
+}
 
-	dev.endpoints[lowCaseKey] = Value
+// parseXaddr returns the URL of the device service of the device at xaddr
+func parseXaddr(xaddr string) (*url.URL, error) {
+	if !strings.Contains(xaddr, "://") {
+		xaddr = "http://" + xaddr
+	}
+	u, err := url.Parse(xaddr)
+	if err != nil {
+		return nil, err
+	}
+	if u.Host == "" {
+		return nil, errors.New("invalid device address " + xaddr)
+	}
+	if u.Path == "" || u.Path == "/" {
+		u.Path = "/onvif/device_service"
+	}
+	return u, nil
 }
 
 // GetEndpoint returns specific ONVIF service endpoint address
 func (dev *Device) GetEndpoint(name string) string {
-	return dev.endpoints[strings.ToLower(name)]
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
+	return dev.dir.endpoints[strings.ToLower(name)]
 }
 
-func (dev Device) buildMethodSOAP(msg string) (gosoap.SoapMessage, error) {
-	doc := etree.NewDocument()
-	if err := doc.ReadFromString(msg); err != nil {
-		//log.Println("Got error")
-
-		return "", err
+func (dev Device) buildMethodSOAP(version SOAPVersion, msg string) (*gosoap.Envelope, error) {
+	soap := newEnvelope(version)
+	if err := soap.AddStringBodyContent(msg); err != nil {
+		return nil, err
 	}
-	element := doc.Root()
-
-	soap := gosoap.NewEmptySOAP()
-	soap.AddBodyContent(element)
+	soap.AddRootNamespaces(Xlmns)
 
 	return soap, nil
 }
 
 // getEndpoint functions get the target service endpoint in a better way
 func (dev Device) getEndpoint(endpoint string) (string, error) {
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
 
 	// common condition, endpointMark in map we use this.
-	if endpointURL, bFound := dev.endpoints[endpoint]; bFound {
+	if endpointURL, bFound := dev.dir.endpoints[endpoint]; bFound {
 		return endpointURL, nil
 	}
 
@@ -246,18 +362,41 @@ func (dev Device) getEndpoint(endpoint string) (string, error) {
 	//and sametime the Targetkey like : events、analytics
 	//we use fuzzy way to find the best match url
 	var endpointURL string
-	for targetKey := range dev.endpoints {
+	for targetKey := range dev.dir.endpoints {
+		// but media must not match media2, that is another version of the service
+		if suffix := strings.TrimPrefix(targetKey, endpoint); suffix != targetKey && isVersionSuffix(suffix) {
+			continue
+		}
 		if strings.Contains(targetKey, endpoint) {
-			endpointURL = dev.endpoints[targetKey]
+			endpointURL = dev.dir.endpoints[targetKey]
 			return endpointURL, nil
 		}
 	}
 	return endpointURL, errors.New("target endpoint service not found")
 }
 
+func isVersionSuffix(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
 // CallMethod functions call an method, defined <method> struct.
 // You should use Authenticate method to call authorized requests.
 func (dev Device) CallMethod(method interface{}) (*http.Response, error) {
+	return dev.CallMethodContext(context.Background(), method)
+}
+
+// CallMethodContext functions call an method, defined <method> struct, bound
+// to ctx. The body of the returned reply stays bound to ctx too: a deadline
+// hit while reading it aborts the read with context.DeadlineExceeded.
+func (dev Device) CallMethodContext(ctx context.Context, method interface{}) (*http.Response, error) {
 	pkgPath := strings.Split(reflect.TypeOf(method).PkgPath(), "/")
 	pkg := strings.ToLower(pkgPath[len(pkgPath)-1])
 
@@ -265,28 +404,190 @@ func (dev Device) CallMethod(method interface{}) (*http.Response, error) {
 	if err != nil {
 		return nil, err
 	}
-	return dev.callMethodDo(endpoint, method)
+	return dev.callMethodDo(ctx, endpoint, nil, method)
+}
+
+// CallEndpointContext sends method to the endpoint reference ref, e.g. the
+// SubscriptionReference of an event subscription for Renew, Unsubscribe or
+// PullMessages, with the reference parameters of ref in the header
+func (dev Device) CallEndpointContext(ctx context.Context, ref event.EndpointReferenceType, method interface{}) (*http.Response, error) {
+	if ref.Address == "" {
+		return nil, errors.New("endpoint reference without address")
+	}
+	return dev.callMethodDo(ctx, dev.reachable(string(ref.Address)), &ref, method)
 }
 
 // CallMethod functions call an method, defined <method> struct with authentication data
-func (dev Device) callMethodDo(endpoint string, method interface{}) (*http.Response, error) {
-	output, err := xml.MarshalIndent(method, "  ", "    ")
+func (dev Device) callMethodDo(ctx context.Context, endpoint string, ref *event.EndpointReferenceType, method interface{}) (*http.Response, error) {
+	// The binary contents of the method are sent as MTOM attachments
+	value := reflect.New(reflect.TypeOf(method))
+	value.Elem().Set(reflect.ValueOf(method))
+	attachments := gosoap.CollectAttachments(value.Interface())
+
+	output, err := xml.MarshalIndent(value.Interface(), "  ", "    ")
 	if err != nil {
 		return nil, err
 	}
 
-	soap, err := dev.buildMethodSOAP(string(output))
+	return dev.callOperation(ctx, endpoint, ref, reflect.TypeOf(method).Name(), string(output), attachments)
+}
+
+// callOperation sends the body of an operation to endpoint, the address of
+// ref if any, and handles the negotiation of the SOAP version and of the
+// authentication with the device
+func (dev Device) callOperation(ctx context.Context, endpoint string, ref *event.EndpointReferenceType, operation, output string, attachments []gosoap.Attachment) (*http.Response, error) {
+	mode := dev.authMode()
+	version := dev.soapVersion()
+	if len(attachments) > 0 && dev.negotiationPending(mode) {
+		// The attachments are streamed, they cannot be sent twice: learn the
+		// SOAP version and the Digest challenge of the device beforehand
+		if err := dev.probe(ctx, endpoint, output); err != nil {
+			return nil, err
+		}
+		mode = dev.authMode()
+		version = dev.soapVersion()
+	}
+	resp, err := dev.sendMethodSOAP(ctx, endpoint, ref, operation, output, mode, version, attachments)
 	if err != nil {
 		return nil, err
 	}
+	if len(attachments) > 0 {
+		// The attachments are streamed, they cannot be sent twice
+		return resp, nil
+	}
 
-	soap.AddRootNamespaces(Xlmns)
-	soap.AddAction()
+	if dev.soapRetry(version, resp) {
+		// The device rejected the SOAP 1.2 envelope, try with the legacy one
+		version = SOAP11
+		resp, err = dev.sendMethodSOAP(ctx, endpoint, ref, operation, output, mode, version, nil)
+		if err != nil {
+			return nil, err
+		}
+		dev.learnLegacySOAP(resp)
+	}
+
+	if dev.digestRetry(mode, resp) {
+		// The device challenged the request, answer with Digest credentials
+		if mode == AuthAuto {
+			mode = AuthDigest
+		}
+		resp, err = dev.sendMethodSOAP(ctx, endpoint, ref, operation, output, mode, version, nil)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode != http.StatusUnauthorized {
+			dev.learnDigestRequired()
+		}
+	}
+
+	if dev.clockRetry(ctx, mode, resp) {
+		// The token was rejected and the clock of the device drifted meanwhile
+		return dev.sendMethodSOAP(ctx, endpoint, ref, operation, output, mode, version, nil)
+	}
+	return resp, nil
+}
+
+// negotiationPending tells if a request could be rejected by the device
+// because of its SOAP version or of a missing Digest challenge
+func (dev Device) negotiationPending(mode AuthMode) bool {
+	if dev.params.SOAPVersion == SOAPAuto && !dev.soap.legacy.Load() {
+		return true
+	}
+	if !dev.hasCredentials() || mode == AuthWSSecurity {
+		return false
+	}
+	dev.auth.Lock()
+	defer dev.auth.Unlock()
+	return dev.auth.challenge == nil
+}
+
+// probe sends GetServiceCapabilities to the service of the operation in
+// output, going through the negotiations of callOperation. Its reply does
+// not matter, only what is learned about the device.
+func (dev Device) probe(ctx context.Context, endpoint, output string) error {
+	decoder := xml.NewDecoder(strings.NewReader(output))
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil
+		}
+		if start, ok := token.(xml.StartElement); ok {
+			if start.Name.Space == "" {
+				return nil
+			}
+			// The prefix of the operation is bound by the root namespaces of the envelope
+			resp, err := dev.callOperation(ctx, endpoint, nil, "GetServiceCapabilities", "<"+start.Name.Space+":GetServiceCapabilities/>", nil)
+			if err != nil {
+				return err
+			}
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			return resp.Body.Close()
+		}
+	}
+}
+
+func (dev Device) sendMethodSOAP(ctx context.Context, endpoint string, ref *event.EndpointReferenceType, operation, method string, mode AuthMode, version SOAPVersion, attachments []gosoap.Attachment) (*http.Response, error) {
+	soap, err := dev.buildMethodSOAP(version, method)
+	if err != nil {
+		return nil, err
+	}
+
+	// The action is also the SOAPAction of SOAP 1.1
+	action := soap.Action()
+	if action == "" {
+		action = operation
+	} else {
+		to := endpoint
+		if ref != nil {
+			to = string(ref.Address)
+		}
+		soap.AddAddressing(action, to)
+	}
+	if ref != nil {
+		if err := soap.AddReferenceParameters(ref.ReferenceParameters.Any); err != nil {
+			return nil, err
+		}
+	}
 
 	//Auth Handling
-	if dev.params.Username != "" && dev.params.Password != "" {
-		soap.AddWSSecurity(dev.params.Username, dev.params.Password)
+	var header http.Header
+	if dev.hasCredentials() {
+		if dev.params.RequireTLS && !strings.EqualFold(strings.SplitN(endpoint, ":", 2)[0], "https") {
+			return nil, ErrInsecureTransport
+		}
+		if mode != AuthDigest {
+			if err := soap.AddWSSecurityAt(dev.params.Username, dev.params.Password, time.Now().Add(dev.ClockOffset())); err != nil {
+				return nil, err
+			}
+		}
+		if mode == AuthDigest || mode == AuthBoth {
+			header = dev.digestHeader(endpoint)
+		}
 	}
 
-	return networking.SendSoap(dev.params.HttpClient, endpoint, soap.String())
+	envelope, err := soap.String()
+	if err != nil {
+		return nil, err
+	}
+	req := &networking.Request{
+		Endpoint:  endpoint,
+		Operation: operation,
+		Envelope:  envelope,
+		Header:    soapHeader(header, version, action),
+	}
+	if len(attachments) > 0 {
+		if req.Header == nil {
+			req.Header = make(http.Header)
+		}
+		envelopeType := req.Header.Get("Content-Type")
+		if envelopeType == "" {
+			envelopeType = "application/soap+xml; charset=utf-8"
+		}
+		var contentType string
+		if req.Body, contentType, err = gosoap.NewMTOMBody(req.Envelope, envelopeType, attachments); err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", contentType)
+	}
+	return dev.transport.RoundTrip(ctx, req)
 }
@@ -0,0 +1,73 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/event"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddressingHeaders(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{})
+
+	for i := 0; i < 2; i++ {
+		resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+		require.NoError(t, err)
+		readAll(t, resp)
+	}
+
+	received := f.received()
+	require.Len(t, received, 2)
+	for _, req := range received {
+		action := req.Envelope.FindElement("./Envelope/Header/Action")
+		require.NotNil(t, action)
+		assert.Equal(t, gosoap.AddressingNamespace, action.NamespaceURI())
+		assert.Equal(t, "http://www.onvif.org/ver10/device/wsdl/GetDeviceInformation", req.text("./Envelope/Header/Action"))
+		assert.Equal(t, dev.GetEndpoint("device"), req.text("./Envelope/Header/To"))
+		assert.True(t, strings.HasPrefix(req.text("./Envelope/Header/MessageID"), "urn:uuid:"))
+	}
+	assert.NotEqual(t, received[0].text("./Envelope/Header/MessageID"), received[1].text("./Envelope/Header/MessageID"))
+}
+
+func TestAddressingEndpointReference(t *testing.T) {
+	f := newFakeDevice(t)
+	var path string
+	f.handle("Unsubscribe", func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.RequestURI()
+		writeEnvelope(w, http.StatusOK, `<wsnt:UnsubscribeResponse xmlns:wsnt="http://docs.oasis-open.org/wsn/b-2"/>`)
+	})
+	dev := f.device(t, DeviceParams{})
+
+	ref := event.EndpointReferenceType{
+		Address: event.AttributedURIType(f.URL + "/onvif/subscription?id=7"),
+		ReferenceParameters: event.ReferenceParametersType{
+			Any: `<dom:SubscriptionId xmlns:dom="http://www.example.com/subscription">7</dom:SubscriptionId>`,
+		},
+	}
+	resp, err := dev.CallEndpointContext(context.Background(), ref, event.Unsubscribe{})
+	require.NoError(t, err)
+	readAll(t, resp)
+	assert.Equal(t, "/onvif/subscription?id=7", path)
+
+	req := f.received()[0]
+	// The action is the one named by the WSDL
+	assert.Equal(t, "http://docs.oasis-open.org/wsn/bw-2/SubscriptionManager/UnsubscribeRequest", req.text("./Envelope/Header/Action"))
+	assert.Equal(t, string(ref.Address), req.text("./Envelope/Header/To"))
+	param := req.Envelope.FindElement("./Envelope/Header/SubscriptionId")
+	require.NotNil(t, param)
+	assert.Equal(t, "http://www.example.com/subscription", param.NamespaceURI())
+	assert.Equal(t, "7", param.Text())
+	assert.Equal(t, "true", param.SelectAttrValue("wsa:IsReferenceParameter", ""))
+
+	_, err = dev.CallEndpointContext(context.Background(), event.EndpointReferenceType{}, event.Unsubscribe{})
+	assert.Error(t, err)
+}
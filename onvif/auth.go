@@ -0,0 +1,120 @@
+package onvif
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+
+	"github.com/BalkarSandhu/go-onvif/networking"
+)
+
+// AuthMode selects how the credentials of DeviceParams are presented to the device
+type AuthMode int
+
+// Authentication modes
+const (
+	// AuthWSSecurity adds a WS-UsernameToken to the SOAP header of each request
+	AuthWSSecurity AuthMode = iota
+	// AuthDigest authenticates the HTTP transport with HTTP Digest (RFC 2617/7616)
+	AuthDigest
+	// AuthBoth presents both the WS-UsernameToken and the HTTP Digest credentials
+	AuthBoth
+	// AuthAuto starts with WS-Security and retries with HTTP Digest when the
+	// device answers with a Digest challenge. Once Digest proved to be required,
+	// it is used for all the subsequent requests to the device.
+	AuthAuto
+)
+
+func (mode AuthMode) String() string {
+	switch mode {
+	case AuthWSSecurity:
+		return "WSSecurity"
+	case AuthDigest:
+		return "Digest"
+	case AuthBoth:
+		return "Both"
+	case AuthAuto:
+		return "Auto"
+	default:
+		return strconv.Itoa(int(mode))
+	}
+}
+
+// authState is shared by all the copies of a Device. It keeps what was learned
+// about the authentication expected by the device.
+type authState struct {
+	sync.Mutex
+	digestRequired bool
+	challenge      *networking.DigestChallenge
+}
+
+func (dev Device) hasCredentials() bool {
+	return dev.params.Username != "" && dev.params.Password != ""
+}
+
+// authMode resolves AuthAuto into the mode learned for the device, if any
+func (dev Device) authMode() AuthMode {
+	if dev.params.AuthMode != AuthAuto {
+		return dev.params.AuthMode
+	}
+	dev.auth.Lock()
+	defer dev.auth.Unlock()
+	if dev.auth.digestRequired {
+		return AuthDigest
+	}
+	return AuthAuto
+}
+
+// digestHeader answers the last Digest challenge of the device, it returns
+// nil if the device never sent any challenge.
+func (dev Device) digestHeader(endpoint string) http.Header {
+	dev.auth.Lock()
+	challenge := dev.auth.challenge
+	dev.auth.Unlock()
+
+	if challenge == nil {
+		return nil
+	}
+
+	uri := "/"
+	if u, err := url.Parse(endpoint); err == nil {
+		uri = u.RequestURI()
+	}
+
+	header := make(http.Header)
+	header.Set("Authorization", challenge.Authorization(http.MethodPost, uri, dev.params.Username, dev.params.Password))
+	return header
+}
+
+// digestRetry tells if the reply is a Digest challenge that deserves the
+// request to be sent again with Digest credentials, and remembers the challenge.
+func (dev Device) digestRetry(mode AuthMode, resp *http.Response) bool {
+	if resp.StatusCode != http.StatusUnauthorized || mode == AuthWSSecurity || !dev.hasCredentials() {
+		return false
+	}
+
+	challenge := networking.FindDigestChallenge(resp.Header)
+	if challenge == nil {
+		return false
+	}
+
+	dev.auth.Lock()
+	dev.auth.challenge = challenge
+	dev.auth.Unlock()
+
+	// Drain the body so that the connection can be reused for the retry
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	return true
+}
+
+func (dev Device) learnDigestRequired() {
+	if dev.params.AuthMode != AuthAuto {
+		return
+	}
+	dev.auth.Lock()
+	dev.auth.digestRequired = true
+	dev.auth.Unlock()
+}
@@ -0,0 +1,175 @@
+package onvif
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRealm = "camera"
+	testNonce = "dcd98b7102dd2f0e8b11d0f600bfb0c093"
+)
+
+// requireDigest answers the requests without valid Digest credentials with a challenge
+func requireDigest(username, password string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !validDigest(r, username, password) {
+			w.Header().Set("WWW-Authenticate", `Digest realm="`+testRealm+`", qop="auth", nonce="`+testNonce+`", algorithm=MD5`)
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func validDigest(r *http.Request, username, password string) bool {
+	scheme, params, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || scheme != "Digest" {
+		return false
+	}
+	p := make(map[string]string)
+	for _, kv := range strings.Split(params, ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(kv), "="); ok {
+			p[k] = strings.Trim(v, `"`)
+		}
+	}
+	h := func(s string) string {
+		sum := md5.Sum([]byte(s))
+		return hex.EncodeToString(sum[:])
+	}
+	ha1 := h(username + ":" + testRealm + ":" + password)
+	ha2 := h(r.Method + ":" + p["uri"])
+	expected := h(ha1 + ":" + p["nonce"] + ":" + p["nc"] + ":" + p["cnonce"] + ":" + p["qop"] + ":" + ha2)
+	return p["username"] == username && p["nonce"] == testNonce && p["uri"] == r.URL.RequestURI() && p["response"] == expected
+}
+
+func hasUsernameToken(req fakeRequest) bool {
+	return req.Envelope.FindElement("./Envelope/Header/Security/UsernameToken") != nil
+}
+
+const deviceInformation = `<tds:GetDeviceInformationResponse>
+	<tds:Manufacturer>ACME</tds:Manufacturer><tds:Model>C1</tds:Model>
+	<tds:FirmwareVersion>1.0</tds:FirmwareVersion><tds:SerialNumber>42</tds:SerialNumber>
+	<tds:HardwareId>1</tds:HardwareId>
+</tds:GetDeviceInformationResponse>`
+
+func TestDigestAuthentication(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetDeviceInformation", requireDigest("admin", "secret", func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, deviceInformation)
+	}))
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", AuthMode: AuthDigest})
+
+	ctx := context.Background()
+	resp, err := dev.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, readAll(t, resp), "ACME")
+
+	// The challenge is answered, then reused
+	resp, err = dev.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+
+	requests := f.received()
+	require.Len(t, requests, 3)
+	assert.Empty(t, requests[0].Header.Get("Authorization"))
+	for _, req := range requests[1:] {
+		assert.True(t, strings.HasPrefix(req.Header.Get("Authorization"), "Digest "))
+		assert.False(t, hasUsernameToken(req), "no WS-Security token in Digest mode")
+	}
+}
+
+func TestDigestWrongPassword(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetDeviceInformation", requireDigest("admin", "secret", func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, deviceInformation)
+	}))
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "wrong", AuthMode: AuthDigest})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	readAll(t, resp)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Len(t, f.received(), 2, "a single retry answers the challenge")
+}
+
+func TestAuthAutoFallsBackToDigest(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetDeviceInformation", requireDigest("admin", "secret", func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, deviceInformation)
+	}))
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", AuthMode: AuthAuto})
+
+	ctx := context.Background()
+	resp, err := dev.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+
+	requests := f.received()
+	require.Len(t, requests, 2)
+	assert.True(t, hasUsernameToken(requests[0]), "WS-Security is tried first")
+	assert.Empty(t, requests[0].Header.Get("Authorization"))
+	assert.NotEmpty(t, requests[1].Header.Get("Authorization"))
+
+	// Digest proved to be required, it is used from the start by all the copies of the device
+	copied := *dev
+	resp, err = copied.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+
+	requests = f.received()
+	require.Len(t, requests, 3)
+	assert.NotEmpty(t, requests[2].Header.Get("Authorization"))
+	assert.False(t, hasUsernameToken(requests[2]))
+}
+
+func TestAuthAutoKeepsWSSecurity(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", AuthMode: AuthAuto})
+
+	for i := 0; i < 2; i++ {
+		resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+		require.NoError(t, err)
+		readAll(t, resp)
+	}
+
+	requests := f.received()
+	require.Len(t, requests, 2)
+	for _, req := range requests {
+		assert.True(t, hasUsernameToken(req))
+		assert.Equal(t, "admin", req.text("./Envelope/Header/Security/UsernameToken/Username"))
+		assert.Empty(t, req.Header.Get("Authorization"))
+	}
+}
+
+func TestWSSecurityIgnoresDigestChallenge(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetDeviceInformation", requireDigest("admin", "secret", func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, deviceInformation)
+	}))
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret"})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	readAll(t, resp)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	// Only the clock is checked, the challenge is not answered
+	assert.Equal(t, []string{"GetDeviceInformation", "GetSystemDateAndTime"}, f.operations())
+	for _, req := range f.received() {
+		assert.Empty(t, req.Header.Get("Authorization"))
+	}
+}
@@ -0,0 +1,363 @@
+package onvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+
+	"github.com/beevik/etree"
+)
+
+// Capability is a feature a device may support. It is named after the service
+// advertising it and the path of the capability in the GetServiceCapabilities
+// reply of the service, e.g. "device.System.SystemBackup". Any such path may be
+// queried, the constants name the most useful ones.
+type Capability string
+
+// Services
+const (
+	CapMedia     Capability = "media"
+	CapMedia2    Capability = "media2"
+	CapPTZ       Capability = "ptz"
+	CapImaging   Capability = "imaging"
+	CapEvents    Capability = "events"
+	CapAnalytics Capability = "analytics"
+	CapRecording Capability = "recording"
+	CapReplay    Capability = "replay"
+	CapDeviceIO  Capability = "deviceio"
+)
+
+// Device capabilities
+const (
+	CapSystemBackup        Capability = "device.System.SystemBackup"
+	CapSystemLogging       Capability = "device.System.SystemLogging"
+	CapFirmwareUpgrade     Capability = "device.System.FirmwareUpgrade"
+	CapHTTPFirmwareUpgrade Capability = "device.System.HttpFirmwareUpgrade"
+	CapDiscoveryBye        Capability = "device.System.DiscoveryBye"
+	CapIPv6                Capability = "device.Network.IPVersion6"
+	CapZeroConfiguration   Capability = "device.Network.ZeroConfiguration"
+	CapHTTPDigest          Capability = "device.Security.HttpDigest"
+	CapUsernameToken       Capability = "device.Security.UsernameToken"
+	CapTLS12               Capability = "device.Security.TLS1.2"
+)
+
+// Media capabilities, of either media service
+const (
+	CapOSD          Capability = "OSD"
+	CapSnapshotURI  Capability = "SnapshotUri"
+	CapRTPMulticast Capability = "RTPMulticast"
+	CapRTSPOverTCP  Capability = "RTP_RTSP_TCP"
+	// CapH265 is derived from the encodings of the Media2 service
+	CapH265 Capability = "H265"
+)
+
+// PTZ capabilities, derived from the spaces of the PTZ nodes
+const (
+	CapPTZAbsoluteMove   Capability = "ptz.AbsoluteMove"
+	CapPTZRelativeMove   Capability = "ptz.RelativeMove"
+	CapPTZContinuousMove Capability = "ptz.ContinuousMove"
+	CapPTZPresets        Capability = "ptz.Presets"
+	CapPTZHome           Capability = "ptz.Home"
+	CapPTZMoveStatus     Capability = "ptz.MoveStatus"
+)
+
+// Event, imaging, analytics and recording capabilities
+const (
+	CapPullPointEvents    Capability = "events.WSPullPointSupport"
+	CapImageStabilization Capability = "imaging.ImageStabilization"
+	CapImagingPresets     Capability = "imaging.Presets"
+	CapAnalyticsRules     Capability = "analytics.RuleSupport"
+	CapAnalyticsModules   Capability = "analytics.AnalyticsModuleSupport"
+	CapDynamicRecordings  Capability = "recording.DynamicRecordings"
+)
+
+// Capabilities is the merged view of the capabilities of all the services of a device
+type Capabilities map[Capability]string
+
+// Supports tells if the capability is advertised, and is true or a positive number
+func (c Capabilities) Supports(capability Capability) bool {
+	value := strings.TrimSpace(c[capability])
+	if b, err := strconv.ParseBool(value); err == nil {
+		return b
+	}
+	n, err := strconv.Atoi(value)
+	return err == nil && n > 0
+}
+
+// Value returns the raw value of the capability, empty if not advertised
+func (c Capabilities) Value(capability Capability) string {
+	return c[capability]
+}
+
+// Int returns the value of a numeric capability, e.g. "media.ProfileCapabilities.MaximumNumberOfProfiles"
+func (c Capabilities) Int(capability Capability) int {
+	n, _ := strconv.Atoi(strings.TrimSpace(c[capability]))
+	return n
+}
+
+// List returns the advertised capabilities, sorted
+func (c Capabilities) List() []Capability {
+	list := make([]Capability, 0, len(c))
+	for capability := range c {
+		list = append(list, capability)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// Supports tells if the device supports the capability. The capabilities
+// are loaded on the first query, bound to ctx.
+func (dev *Device) Supports(ctx context.Context, capability Capability) (bool, error) {
+	caps, err := dev.cachedCapabilities(ctx)
+	if err != nil {
+		return false, err
+	}
+	return caps.Supports(capability), nil
+}
+
+// Capabilities returns a copy of the capabilities of the device, loaded on the first call
+func (dev *Device) Capabilities(ctx context.Context) (Capabilities, error) {
+	caps, err := dev.cachedCapabilities(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return maps.Clone(caps), nil
+}
+
+// cachedCapabilities returns the capabilities shared by the copies of the
+// device, which must not be modified
+func (dev *Device) cachedCapabilities(ctx context.Context) (Capabilities, error) {
+	caps := dev.dir.capabilities()
+	if caps != nil {
+		return caps, nil
+	}
+
+	dev.dir.capsLoading.Lock()
+	defer dev.dir.capsLoading.Unlock()
+
+	// Loaded meanwhile
+	if caps = dev.dir.capabilities(); caps != nil {
+		return caps, nil
+	}
+	return dev.loadCapabilities(ctx, true)
+}
+
+// LoadCapabilities queries the capabilities of all the services of the device
+// again, with GetServiceCapabilities, or GetCapabilities for the older devices
+func (dev *Device) LoadCapabilities(ctx context.Context) (Capabilities, error) {
+	dev.dir.capsLoading.Lock()
+	defer dev.dir.capsLoading.Unlock()
+	caps, err := dev.loadCapabilities(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	return maps.Clone(caps), nil
+}
+
+// loadCapabilities loads the capabilities of all the services, the ones
+// advertised by GetServices being used instead of querying them if advertised
+func (dev *Device) loadCapabilities(ctx context.Context, advertised bool) (Capabilities, error) {
+	caps := make(Capabilities)
+	services := dev.SupportedServices()
+
+	deviceLoaded := false
+	for ns := range services {
+		key := serviceKey(ns)
+		caps[Capability(key)] = "true"
+
+		if c := dev.dir.advertisedCapabilities(ns); advertised && c != nil {
+			caps.addElement(key, c)
+			deviceLoaded = deviceLoaded || ns == DeviceNamespace
+			continue
+		}
+
+		endpoint, err := dev.getEndpoint(key)
+		if err != nil {
+			continue
+		}
+		root, err := dev.callForDocument(ctx, endpoint, "GetServiceCapabilities", `<GetServiceCapabilities xmlns="`+ns+`"/>`)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return nil, ctxErr
+			}
+			continue
+		}
+		if c := root.FindElement("./Body/GetServiceCapabilitiesResponse/Capabilities"); c != nil {
+			caps.addElement(key, c)
+			deviceLoaded = deviceLoaded || ns == DeviceNamespace
+		}
+	}
+
+	if !deviceLoaded {
+		endpoint, err := dev.getEndpoint("device")
+		if err != nil {
+			return nil, err
+		}
+		root, err := dev.callForDocument(ctx, endpoint, "GetCapabilities",
+			`<GetCapabilities xmlns="`+DeviceNamespace+`"><Category>All</Category></GetCapabilities>`)
+		if err != nil {
+			return nil, err
+		}
+		if c := root.FindElement("./Body/GetCapabilitiesResponse/Capabilities"); c != nil {
+			caps.addCategories(c)
+		}
+	}
+
+	// The errors of the optional queries are ignored, the capabilities are then not advertised
+	if caps.Supports(CapPTZ) {
+		dev.loadPTZCapabilities(ctx, caps)
+	}
+	if caps.Supports(CapMedia2) {
+		dev.loadEncodingCapabilities(ctx, caps)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, media := range []Capability{CapOSD, CapSnapshotURI} {
+		caps.merge(media, "media."+media, "media2."+media)
+	}
+	for _, streaming := range []Capability{CapRTPMulticast, CapRTSPOverTCP} {
+		caps.merge(streaming, "media.StreamingCapabilities."+streaming, "media2.StreamingCapabilities."+streaming)
+	}
+
+	dev.dir.Lock()
+	dev.dir.caps = caps
+	dev.dir.Unlock()
+	return caps, nil
+}
+
+// addElement records the attributes and the leaf values of e and its children
+func (c Capabilities) addElement(prefix string, e *etree.Element) {
+	for _, attr := range e.Attr {
+		if attr.Space == "xmlns" || attr.Key == "xmlns" {
+			continue
+		}
+		c[Capability(prefix+"."+attr.Key)] = attr.Value
+	}
+	children := e.ChildElements()
+	if len(children) == 0 && len(e.Attr) == 0 {
+		if text := strings.TrimSpace(e.Text()); text != "" {
+			c[Capability(prefix)] = text
+		}
+	}
+	for _, child := range children {
+		if child.Tag == "XAddr" {
+			continue
+		}
+		c.addElement(prefix+"."+child.Tag, child)
+	}
+}
+
+// addCategories records the capabilities of a GetCapabilities reply, whose
+// categories are named after the services
+func (c Capabilities) addCategories(e *etree.Element) {
+	for _, category := range e.ChildElements() {
+		if category.Tag == "Extension" {
+			c.addCategories(category)
+			continue
+		}
+		key := strings.ToLower(category.Tag)
+		if ns, ok := capabilityNamespaces[key]; ok {
+			key = serviceKey(ns)
+		}
+		c[Capability(key)] = "true"
+		c.addElement(key, category)
+	}
+}
+
+// merge sets capability to the first of the sources that is supported
+func (c Capabilities) merge(capability Capability, sources ...Capability) {
+	for _, source := range sources {
+		if c.Supports(source) {
+			c[capability] = c[source]
+			return
+		}
+	}
+}
+
+func (dev *Device) loadPTZCapabilities(ctx context.Context, caps Capabilities) error {
+	endpoint, err := dev.getEndpoint("ptz")
+	if err != nil {
+		return err
+	}
+	root, err := dev.callForDocument(ctx, endpoint, "GetNodes", `<GetNodes xmlns="`+PTZNamespace+`"/>`)
+	if err != nil {
+		return err
+	}
+
+	spaces := map[string]Capability{
+		"AbsolutePanTiltPositionSpace":    CapPTZAbsoluteMove,
+		"AbsoluteZoomPositionSpace":       CapPTZAbsoluteMove,
+		"RelativePanTiltTranslationSpace": CapPTZRelativeMove,
+		"RelativeZoomTranslationSpace":    CapPTZRelativeMove,
+		"ContinuousPanTiltVelocitySpace":  CapPTZContinuousMove,
+		"ContinuousZoomVelocitySpace":     CapPTZContinuousMove,
+	}
+	for _, node := range root.FindElements("./Body/GetNodesResponse/PTZNode") {
+		if supported := node.FindElement("SupportedPTZSpaces"); supported != nil {
+			for _, space := range supported.ChildElements() {
+				if capability, ok := spaces[space.Tag]; ok {
+					caps[capability] = "true"
+				}
+			}
+		}
+		if n, _ := strconv.Atoi(strings.TrimSpace(elementText(node, "MaximumNumberOfPresets"))); n > 0 {
+			caps[CapPTZPresets] = "true"
+		}
+		if home, _ := strconv.ParseBool(strings.TrimSpace(elementText(node, "HomeSupported"))); home {
+			caps[CapPTZHome] = "true"
+		}
+	}
+	return nil
+}
+
+func (dev *Device) loadEncodingCapabilities(ctx context.Context, caps Capabilities) error {
+	endpoint, err := dev.getEndpoint("media2")
+	if err != nil {
+		return err
+	}
+	root, err := dev.callForDocument(ctx, endpoint, "GetVideoEncoderConfigurationOptions",
+		`<GetVideoEncoderConfigurationOptions xmlns="`+Media2Namespace+`"/>`)
+	if err != nil {
+		return err
+	}
+	for _, encoding := range root.FindElements("./Body/GetVideoEncoderConfigurationOptionsResponse/Options/Encoding") {
+		switch strings.ToUpper(strings.TrimSpace(encoding.Text())) {
+		case "H265", "H.265", "HEVC":
+			caps[CapH265] = "true"
+		}
+	}
+	return nil
+}
+
+// callForDocument sends the body of an operation to endpoint, and parses the reply
+func (dev Device) callForDocument(ctx context.Context, endpoint, operation, body string) (*etree.Element, error) {
+	resp, err := dev.callOperation(ctx, endpoint, nil, operation, body, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	if err = gosoap.FaultFromReply(resp.StatusCode, data); err != nil {
+		return nil, err
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return nil, err
+	}
+	if doc.Root() == nil {
+		return nil, errors.New("empty reply to " + operation)
+	}
+	return doc.Root(), nil
+}
@@ -0,0 +1,181 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serviceCapabilities answers GetServiceCapabilities with the capabilities of
+// the service whose endpoint is requested
+func serviceCapabilities(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/device_service"):
+		writeEnvelope(w, http.StatusOK, `<tds:GetServiceCapabilitiesResponse><tds:Capabilities>
+			<tds:Network IPVersion6="true" ZeroConfiguration="false"/>
+			<tds:Security HttpDigest="false" UsernameToken="true"/>
+			<tds:System SystemBackup="true" FirmwareUpgrade="true"/>
+		</tds:Capabilities></tds:GetServiceCapabilitiesResponse>`)
+	case strings.HasSuffix(r.URL.Path, "/media_service"):
+		writeEnvelope(w, http.StatusOK, `<trt:GetServiceCapabilitiesResponse><trt:Capabilities SnapshotUri="true" OSD="false">
+			<trt:ProfileCapabilities MaximumNumberOfProfiles="8"/>
+			<trt:StreamingCapabilities RTPMulticast="false" RTP_RTSP_TCP="true"/>
+		</trt:Capabilities></trt:GetServiceCapabilitiesResponse>`)
+	case strings.HasSuffix(r.URL.Path, "/media2_service"):
+		writeEnvelope(w, http.StatusOK, `<tr2:GetServiceCapabilitiesResponse><tr2:Capabilities OSD="true" SnapshotUri="true">
+			<tr2:StreamingCapabilities RTPMulticast="true"/>
+		</tr2:Capabilities></tr2:GetServiceCapabilitiesResponse>`)
+	default:
+		writeFault(w, http.StatusInternalServerError, "env:Receiver", "ter:ActionNotSupported", "no capabilities")
+	}
+}
+
+func newCapableDevice(t *testing.T) (*fakeDevice, *Device) {
+	f := newFakeDevice(t)
+	f.reply("GetServices", getServices)
+	f.handle("GetServiceCapabilities", serviceCapabilities)
+	f.reply("GetVideoEncoderConfigurationOptions", `<tr2:GetVideoEncoderConfigurationOptionsResponse>
+		<tr2:Options><tt:Encoding>H264</tt:Encoding></tr2:Options>
+		<tr2:Options><tt:Encoding>H265</tt:Encoding></tr2:Options>
+	</tr2:GetVideoEncoderConfigurationOptionsResponse>`)
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	return f, dev
+}
+
+func TestCapabilities(t *testing.T) {
+	f, dev := newCapableDevice(t)
+	ctx := context.Background()
+	requests := len(f.received())
+
+	caps, err := dev.Capabilities(ctx)
+	require.NoError(t, err)
+	for capability, supported := range map[Capability]bool{
+		CapMedia:             true,
+		CapMedia2:            true,
+		CapPTZ:               false,
+		CapSystemBackup:      true,
+		CapIPv6:              true,
+		CapHTTPDigest:        false,
+		CapZeroConfiguration: false,
+		CapUsernameToken:     true,
+		CapSystemLogging:     false,
+		CapH265:              true,
+		// Merged from the media services: the first one supporting it wins
+		CapOSD:          true,
+		CapSnapshotURI:  true,
+		CapRTPMulticast: true,
+		CapRTSPOverTCP:  true,
+	} {
+		assert.Equal(t, supported, caps.Supports(capability), capability)
+	}
+	assert.Equal(t, 8, caps.Int("media.ProfileCapabilities.MaximumNumberOfProfiles"))
+	assert.Equal(t, "true", caps.Value(CapFirmwareUpgrade))
+	assert.Contains(t, caps.List(), CapUsernameToken)
+	// GetServiceCapabilities of each service, and the encodings of Media2
+	assert.Len(t, f.received(), requests+5)
+
+	// Loaded once
+	supported, err := dev.Supports(ctx, CapSystemBackup)
+	require.NoError(t, err)
+	assert.True(t, supported)
+	assert.Len(t, f.received(), requests+5)
+
+	// LoadCapabilities queries the device again
+	_, err = dev.LoadCapabilities(ctx)
+	require.NoError(t, err)
+	assert.Len(t, f.received(), requests+10)
+}
+
+func TestCapabilitiesOfGetServices(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetServices", `<tds:GetServicesResponse>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver10/device/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/device_service</tds:XAddr>
+		<tds:Capabilities><tds:Capabilities><tds:System SystemBackup="true"/></tds:Capabilities></tds:Capabilities>
+		<tds:Version><tt:Major>21</tt:Major><tt:Minor>12</tt:Minor></tds:Version>
+	</tds:Service>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver10/media/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/media_service</tds:XAddr>
+		<tds:Version><tt:Major>2</tt:Major><tt:Minor>60</tt:Minor></tds:Version>
+	</tds:Service>
+</tds:GetServicesResponse>`)
+	f.handle("GetServiceCapabilities", serviceCapabilities)
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	assert.Equal(t, "true", f.received()[1].text("./Envelope/Body/GetServices/IncludeCapability"))
+	requests := len(f.received())
+
+	// Only the media service, whose capabilities are not included, is queried
+	caps, err := dev.Capabilities(context.Background())
+	require.NoError(t, err)
+	assert.True(t, caps.Supports(CapSystemBackup))
+	assert.False(t, caps.Supports(CapFirmwareUpgrade))
+	assert.True(t, caps.Supports(CapSnapshotURI))
+	assert.Len(t, f.received(), requests+1)
+}
+
+func TestCapabilitiesAreCopied(t *testing.T) {
+	_, dev := newCapableDevice(t)
+	ctx := context.Background()
+
+	caps, err := dev.Capabilities(ctx)
+	require.NoError(t, err)
+	caps[CapPTZ] = "true"
+	delete(caps, CapSystemBackup)
+
+	supported, err := dev.Supports(ctx, CapPTZ)
+	require.NoError(t, err)
+	assert.False(t, supported)
+	supported, err = dev.Supports(ctx, CapSystemBackup)
+	require.NoError(t, err)
+	assert.True(t, supported)
+
+	state := dev.State()
+	state.Capabilities[CapPTZ] = "true"
+	restored, err := RestoreDevice(DeviceParams{}, state)
+	require.NoError(t, err)
+	state.Capabilities[CapMedia] = "false"
+	supported, err = restored.Supports(ctx, CapMedia)
+	require.NoError(t, err)
+	assert.True(t, supported)
+	supported, err = dev.Supports(ctx, CapPTZ)
+	require.NoError(t, err)
+	assert.False(t, supported)
+}
+
+func TestCapabilitiesFallback(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetCapabilities", getCapabilities)
+	dev := f.device(t, DeviceParams{})
+	dev.dir = newDirectory()
+	dev.addEndpoint("device", f.URL+"/onvif/device_service")
+
+	// No service answers GetServiceCapabilities
+	caps, err := dev.Capabilities(context.Background())
+	require.NoError(t, err)
+	assert.True(t, caps.Supports(CapMedia))
+	assert.True(t, caps.Supports(CapPTZ))
+	assert.True(t, caps.Supports(CapRecording))
+	assert.False(t, caps.Supports(CapImaging))
+}
+
+func TestSupportsError(t *testing.T) {
+	f := newFakeDevice(t)
+	dev := f.device(t, DeviceParams{})
+
+	supported, err := dev.Supports(context.Background(), CapSystemBackup)
+	assert.Error(t, err)
+	assert.False(t, supported)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = dev.Supports(ctx, CapSystemBackup)
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,122 @@
+package onvif
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+)
+
+// clockState is shared by all the copies of a Device. It keeps the offset
+// between the clock of the device and the clock of the host.
+type clockState struct {
+	offset atomic.Int64
+}
+
+// ClockOffset returns the offset of the clock of the device relatively to the
+// local clock (device time minus local time), as measured by the last SyncClock.
+// It is applied to the creation time of every WS-Security token sent to the device.
+func (dev *Device) ClockOffset() time.Duration {
+	return time.Duration(dev.clock.offset.Load())
+}
+
+// SyncClock measures the offset of the clock of the device with an unauthenticated
+// GetSystemDateAndTime, as allowed by the ONVIF Core specification.
+func (dev *Device) SyncClock(ctx context.Context) error {
+	_, err := dev.syncClock(ctx)
+	return err
+}
+
+// syncClock updates the clock offset and returns the variation it caused
+func (dev Device) syncClock(ctx context.Context) (time.Duration, error) {
+	endpoint, err := dev.getEndpoint("device")
+	if err != nil {
+		return 0, err
+	}
+
+	anonymous := dev
+	anonymous.params.Username = ""
+	anonymous.params.Password = ""
+
+	sent := time.Now()
+	resp, err := anonymous.callMethodDo(ctx, endpoint, nil, device.GetSystemDateAndTime{})
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return 0, err
+	}
+	if err = gosoap.FaultFromReply(resp.StatusCode, data); err != nil {
+		return 0, err
+	}
+
+	var reply struct {
+		Body struct {
+			GetSystemDateAndTimeResponse struct {
+				SystemDateAndTime struct {
+					UTCDateTime *struct {
+						Date struct{ Year, Month, Day int }
+						Time struct{ Hour, Minute, Second int }
+					}
+				}
+			}
+		}
+	}
+	if err = xml.Unmarshal(data, &reply); err != nil {
+		return 0, err
+	}
+	utc := reply.Body.GetSystemDateAndTimeResponse.SystemDateAndTime.UTCDateTime
+	if utc == nil || utc.Date.Year == 0 {
+		return 0, errors.New("the device did not report its UTC time")
+	}
+
+	deviceTime := time.Date(utc.Date.Year, time.Month(utc.Date.Month), utc.Date.Day,
+		utc.Time.Hour, utc.Time.Minute, utc.Time.Second, 0, time.UTC)
+	// The device answered somewhere during the round trip, assume the middle
+	localTime := sent.Add(received.Sub(sent) / 2)
+
+	offset := deviceTime.Sub(localTime)
+	previous := dev.clock.offset.Swap(int64(offset))
+	return offset - time.Duration(previous), nil
+}
+
+// clockRetry tells if the reply is an authentication fault caused by a drift of
+// the clock of the device, that deserves the request to be sent again with a
+// corrected WS-Security token. The body of resp is left readable.
+func (dev Device) clockRetry(ctx context.Context, mode AuthMode, resp *http.Response) bool {
+	if mode == AuthDigest || !dev.hasCredentials() {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusBadRequest, http.StatusUnauthorized, http.StatusInternalServerError:
+	default:
+		return false
+	}
+
+	if !gosoap.IsNotAuthorized(gosoap.FaultFromReply(resp.StatusCode, peekBody(resp))) {
+		return false
+	}
+
+	// Release the reply before the resync, it may hold the turn of the
+	// request when the concurrency of the device is limited
+	data, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+
+	drift, err := dev.syncClock(ctx)
+	if err != nil || drift.Abs() <= time.Second {
+		return false
+	}
+	return true
+}
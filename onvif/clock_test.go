@@ -0,0 +1,96 @@
+package onvif
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// driftedClock serves the time of a device whose clock is ahead by drift, and
+// rejects the tokens created more than 5 seconds away from it
+func driftedClock(f *fakeDevice, drift time.Duration) {
+	f.handle("GetSystemDateAndTime", func(w http.ResponseWriter, r *http.Request) {
+		now := time.Now().Add(drift).UTC()
+		writeEnvelope(w, http.StatusOK, fmt.Sprintf(`<tds:GetSystemDateAndTimeResponse><tds:SystemDateAndTime>
+			<tt:DateTimeType>NTP</tt:DateTimeType><tt:DaylightSavings>false</tt:DaylightSavings>
+			<tt:UTCDateTime>
+				<tt:Time><tt:Hour>%d</tt:Hour><tt:Minute>%d</tt:Minute><tt:Second>%d</tt:Second></tt:Time>
+				<tt:Date><tt:Year>%d</tt:Year><tt:Month>%d</tt:Month><tt:Day>%d</tt:Day></tt:Date>
+			</tt:UTCDateTime>
+		</tds:SystemDateAndTime></tds:GetSystemDateAndTimeResponse>`,
+			now.Hour(), now.Minute(), now.Second(), now.Year(), now.Month(), now.Day()))
+	})
+}
+
+func checkTokenTime(f *fakeDevice, drift time.Duration, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		requests := f.received()
+		created, err := time.Parse(time.RFC3339Nano, requests[len(requests)-1].text("./Envelope/Header/Security/UsernameToken/Created"))
+		if err != nil || created.Sub(time.Now().Add(drift)).Abs() > 5*time.Second {
+			writeFault(w, http.StatusBadRequest, "env:Sender", "ter:NotAuthorized", "Sender not Authorized")
+			return
+		}
+		next(w, r)
+	}
+}
+
+func TestClockSkewRetry(t *testing.T) {
+	const drift = time.Hour
+	f := newFakeDevice(t)
+	driftedClock(f, drift)
+	f.handle("GetDeviceInformation", checkTokenTime(f, drift, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, deviceInformation)
+	}))
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret"})
+
+	ctx := context.Background()
+	resp, err := dev.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, readAll(t, resp), "ACME")
+	assert.Equal(t, []string{"GetDeviceInformation", "GetSystemDateAndTime", "GetDeviceInformation"}, f.operations())
+	assert.InDelta(t, float64(drift), float64(dev.ClockOffset()), float64(2*time.Second))
+
+	// The offset is kept for the following tokens
+	resp, err = dev.CallMethodContext(ctx, device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), 4)
+}
+
+func TestClockSkewWithoutDrift(t *testing.T) {
+	f := newFakeDevice(t)
+	driftedClock(f, 0)
+	f.handle("GetDeviceInformation", func(w http.ResponseWriter, r *http.Request) {
+		writeFault(w, http.StatusBadRequest, "env:Sender", "ter:NotAuthorized", "Sender not Authorized")
+	})
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "wrong"})
+
+	// The clock is right, the fault is the answer to the request
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, readAll(t, resp), "NotAuthorized")
+	assert.Equal(t, []string{"GetDeviceInformation", "GetSystemDateAndTime"}, f.operations())
+}
+
+func TestSyncClockIsAnonymous(t *testing.T) {
+	f := newFakeDevice(t)
+	driftedClock(f, -10*time.Minute)
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret"})
+
+	require.NoError(t, dev.SyncClock(context.Background()))
+	assert.InDelta(t, float64(-10*time.Minute), float64(dev.ClockOffset()), float64(2*time.Second))
+
+	requests := f.received()
+	require.Len(t, requests, 1)
+	assert.False(t, hasUsernameToken(requests[0]))
+}
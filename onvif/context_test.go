@@ -0,0 +1,36 @@
+package onvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// stalled answers with the headers and the start of an envelope, then stalls
+// until the client gives up
+func stalled(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, testEnvelopeStart)
+	w.(http.Flusher).Flush()
+	<-r.Context().Done()
+}
+
+func TestNewDeviceContextDeadline(t *testing.T) {
+	f := newFakeDevice(t)
+	for _, op := range []string{"GetSystemDateAndTime", "GetServices", "GetCapabilities", "GetDeviceInformation"} {
+		f.handle(op, stalled)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := NewDeviceContext(ctx, DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
@@ -0,0 +1,220 @@
+package onvif
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/networking"
+
+	"github.com/beevik/etree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDevice is an ONVIF device answering each request with the handler of
+// its operation, the operations without handler failing with a 500
+type fakeDevice struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	handlers map[string]http.HandlerFunc
+	requests []fakeRequest
+}
+
+// fakeRequest is a request received by a fakeDevice
+type fakeRequest struct {
+	Operation string
+	Header    http.Header
+	// Envelope is the SOAP envelope, the root part of an MTOM message
+	Envelope *etree.Document
+}
+
+func newFakeDevice(t *testing.T) *fakeDevice {
+	f := &fakeDevice{handlers: make(map[string]http.HandlerFunc)}
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serve))
+	t.Cleanup(f.Close)
+	return f
+}
+
+// newFakeTLSDevice returns a fakeDevice served over HTTPS
+func newFakeTLSDevice(t *testing.T) *fakeDevice {
+	f := &fakeDevice{handlers: make(map[string]http.HandlerFunc)}
+	f.Server = httptest.NewUnstartedServer(http.HandlerFunc(f.serve))
+	// The handshakes rejected by the clients are expected
+	f.Config.ErrorLog = log.New(io.Discard, "", 0)
+	f.StartTLS()
+	t.Cleanup(f.Close)
+	return f
+}
+
+func (f *fakeDevice) serve(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	r.Body = io.NopCloser(bytes.NewReader(data))
+
+	envelope := data
+	if contentType := r.Header.Get("Content-Type"); gosoap.IsMTOM(contentType) {
+		if m, err := gosoap.NewMTOMReader(contentType, io.NopCloser(bytes.NewReader(data))); err == nil {
+			envelope = m.Envelope
+		}
+	}
+	req := fakeRequest{Header: r.Header.Clone(), Envelope: etree.NewDocument()}
+	if err := req.Envelope.ReadFromBytes(envelope); err == nil {
+		if op := req.Envelope.FindElement("./Envelope/Body/*"); op != nil {
+			req.Operation = op.Tag
+		}
+	}
+
+	f.mu.Lock()
+	f.requests = append(f.requests, req)
+	handler := f.handlers[req.Operation]
+	f.mu.Unlock()
+
+	if handler == nil {
+		writeFault(w, http.StatusInternalServerError, "env:Receiver", "ter:ActionNotSupported", "no handler for "+req.Operation)
+		return
+	}
+	handler(w, r)
+}
+
+// handle sets the handler of the requests of operation
+func (f *fakeDevice) handle(operation string, handler http.HandlerFunc) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.handlers[operation] = handler
+}
+
+// reply answers the requests of operation with a SOAP Body holding body
+func (f *fakeDevice) reply(operation, body string) {
+	f.handle(operation, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, body)
+	})
+}
+
+// received returns the requests received so far
+func (f *fakeDevice) received() []fakeRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]fakeRequest(nil), f.requests...)
+}
+
+// operations returns the operations of the requests received so far
+func (f *fakeDevice) operations() []string {
+	var ops []string
+	for _, req := range f.received() {
+		ops = append(ops, req.Operation)
+	}
+	return ops
+}
+
+// device returns a Device using the fake device for all its services, without network I/O
+func (f *fakeDevice) device(t *testing.T, params DeviceParams) *Device {
+	t.Helper()
+	if params.Xaddr == "" {
+		params.Xaddr = f.URL
+	}
+	if params.HttpClient == nil && params.TLS == nil {
+		params.HttpClient = f.Client()
+	}
+	endpoints := make(map[string]string)
+	for _, key := range []string{"device", "media", "media2", "ptz", "imaging", "events", "analytics", "recording"} {
+		endpoints[key] = f.URL + "/onvif/" + key + "_service"
+	}
+	dev, err := RestoreDevice(params, DeviceState{Endpoints: endpoints})
+	require.NoError(t, err)
+	return dev
+}
+
+const testEnvelopeStart = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"
+	xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl"
+	xmlns:trt="http://www.onvif.org/ver10/media/wsdl"
+	xmlns:tr2="http://www.onvif.org/ver20/media/wsdl"
+	xmlns:tptz="http://www.onvif.org/ver20/ptz/wsdl"
+	xmlns:ter="http://www.onvif.org/ver10/error"><env:Body>`
+
+const testEnvelopeEnd = `</env:Body></env:Envelope>`
+
+// writeEnvelope answers with a SOAP 1.2 envelope whose Body holds body
+func writeEnvelope(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+	w.WriteHeader(status)
+	io.WriteString(w, testEnvelopeStart+body+testEnvelopeEnd)
+}
+
+// writeFault answers with a SOAP 1.2 fault
+func writeFault(w http.ResponseWriter, status int, code, subcode, reason string) {
+	writeEnvelope(w, status, `<env:Fault><env:Code><env:Value>`+code+`</env:Value>`+
+		`<env:Subcode><env:Value>`+subcode+`</env:Value></env:Subcode></env:Code>`+
+		`<env:Reason><env:Text xml:lang="en">`+reason+`</env:Text></env:Reason></env:Fault>`)
+}
+
+// readAll reads and closes the body of resp
+func readAll(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return string(data)
+}
+
+// text returns the text of the element at path in the envelope of req
+func (req fakeRequest) text(path string) string {
+	if e := req.Envelope.FindElement(path); e != nil {
+		return strings.TrimSpace(e.Text())
+	}
+	return ""
+}
+
+func TestCustomTransportAndMiddlewares(t *testing.T) {
+	var calls []string
+	var sent []*networking.Request
+	transport := networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+		calls = append(calls, "transport")
+		sent = append(sent, req)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"application/soap+xml"}},
+			Body:       io.NopCloser(strings.NewReader(testEnvelopeStart + deviceInformation + testEnvelopeEnd)),
+		}, nil
+	})
+	middleware := func(name string) networking.Middleware {
+		return func(next networking.Transport) networking.Transport {
+			return networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+				calls = append(calls, name)
+				return next.RoundTrip(ctx, req)
+			})
+		}
+	}
+
+	dev, err := NewDeviceOffline(DeviceParams{
+		Xaddr:       "camera.local",
+		Transport:   transport,
+		Middlewares: []networking.Middleware{middleware("first"), middleware("second")},
+	})
+	require.NoError(t, err)
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Contains(t, readAll(t, resp), "ACME")
+
+	assert.Equal(t, []string{"first", "second", "transport"}, calls)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "http://camera.local/onvif/device_service", sent[0].Endpoint)
+	assert.Equal(t, "GetDeviceInformation", sent[0].Operation)
+	assert.Contains(t, sent[0].Envelope, "GetDeviceInformation")
+	assert.Nil(t, sent[0].Body)
+}
@@ -0,0 +1,90 @@
+package onvif
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+
+	"github.com/gofrs/uuid"
+)
+
+// identityNamespace is the namespace of the UUIDs returned by Identity
+var identityNamespace = uuid.NewV5(uuid.NamespaceURL, "http://www.onvif.org/ver10/device/wsdl#identity")
+
+// LoadInfo calls GetDeviceInformation and GetEndpointReference, and caches their
+// results for GetDeviceInfo and Identity. Call it again to refresh them.
+func (dev *Device) LoadInfo(ctx context.Context) error {
+	var information struct {
+		Body struct {
+			GetDeviceInformationResponse device.GetDeviceInformationResponse
+		}
+	}
+	if err := dev.callAndParse(ctx, device.GetDeviceInformation{}, &information); err != nil {
+		return err
+	}
+	reply := information.Body.GetDeviceInformationResponse
+	info := DeviceInfo{
+		Manufacturer:    strings.TrimSpace(reply.Manufacturer),
+		Model:           strings.TrimSpace(reply.Model),
+		FirmwareVersion: strings.TrimSpace(reply.FirmwareVersion),
+		SerialNumber:    strings.TrimSpace(reply.SerialNumber),
+		HardwareId:      strings.TrimSpace(reply.HardwareId),
+	}
+
+	// GetEndpointReference is optional, many devices do not implement it
+	var reference struct {
+		Body struct {
+			GetEndpointReferenceResponse device.GetEndpointReferenceResponse
+		}
+	}
+	if err := dev.callAndParse(ctx, device.GetEndpointReference{}, &reference); err == nil {
+		info.EndpointReference = strings.TrimSpace(reference.Body.GetEndpointReferenceResponse.GUID)
+	} else if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	dev.dir.Lock()
+	dev.dir.info = info
+	dev.dir.Unlock()
+	return nil
+}
+
+// Identity returns a stable identifier of the device, that does not depend on
+// its address. It is a UUID derived from the manufacturer, the model, the serial
+// number and the endpoint reference of the device, or an empty string when
+// LoadInfo did not succeed yet.
+func (dev *Device) Identity() string {
+	info := dev.GetDeviceInfo()
+	if info.SerialNumber == "" && info.EndpointReference == "" {
+		return ""
+	}
+	name := strings.Join([]string{
+		info.Manufacturer,
+		info.Model,
+		info.SerialNumber,
+		strings.ToLower(strings.TrimPrefix(info.EndpointReference, "urn:uuid:")),
+	}, "\n")
+	return uuid.NewV5(identityNamespace, name).String()
+}
+
+// callAndParse calls method and decodes the reply envelope into reply
+func (dev Device) callAndParse(ctx context.Context, method interface{}, reply interface{}) error {
+	resp, err := dev.CallMethodContext(ctx, method)
+	if err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if err = gosoap.FaultFromReply(resp.StatusCode, data); err != nil {
+		return err
+	}
+	return xml.Unmarshal(data, reply)
+}
@@ -0,0 +1,73 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const endpointReference = `<tds:GetEndpointReferenceResponse>
+	<tds:GUID>urn:uuid:6A8B3F1C-0D6E-4E5A-9F3B-2C1D0E9F8A7B</tds:GUID>
+</tds:GetEndpointReferenceResponse>`
+
+func TestLoadInfo(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", `<tds:GetDeviceInformationResponse>
+		<tds:Manufacturer> ACME </tds:Manufacturer><tds:Model>C1</tds:Model>
+		<tds:FirmwareVersion>1.0</tds:FirmwareVersion><tds:SerialNumber>42</tds:SerialNumber>
+		<tds:HardwareId>1</tds:HardwareId>
+	</tds:GetDeviceInformationResponse>`)
+	f.reply("GetEndpointReference", endpointReference)
+	dev := f.device(t, DeviceParams{})
+	assert.Empty(t, dev.Identity(), "no identity before LoadInfo")
+
+	require.NoError(t, dev.LoadInfo(context.Background()))
+	assert.Equal(t, DeviceInfo{
+		Manufacturer:      "ACME",
+		Model:             "C1",
+		FirmwareVersion:   "1.0",
+		SerialNumber:      "42",
+		HardwareId:        "1",
+		EndpointReference: "urn:uuid:6A8B3F1C-0D6E-4E5A-9F3B-2C1D0E9F8A7B",
+	}, dev.GetDeviceInfo())
+
+	identity := dev.Identity()
+	assert.Len(t, identity, 36)
+
+	// The identity does not depend on the address of the device, nor on the case of the GUID
+	other := newFakeDevice(t)
+	other.reply("GetDeviceInformation", deviceInformation)
+	other.reply("GetEndpointReference", `<tds:GetEndpointReferenceResponse>
+		<tds:GUID>urn:uuid:6a8b3f1c-0d6e-4e5a-9f3b-2c1d0e9f8a7b</tds:GUID>
+	</tds:GetEndpointReferenceResponse>`)
+	moved := other.device(t, DeviceParams{})
+	require.NoError(t, moved.LoadInfo(context.Background()))
+	assert.Equal(t, identity, moved.Identity())
+}
+
+func TestLoadInfoWithoutEndpointReference(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{})
+
+	require.NoError(t, dev.LoadInfo(context.Background()))
+	assert.Equal(t, "42", dev.GetDeviceInfo().SerialNumber)
+	assert.Empty(t, dev.GetDeviceInfo().EndpointReference)
+	assert.NotEmpty(t, dev.Identity())
+}
+
+func TestLoadInfoFailureKeepsInfo(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{})
+	require.NoError(t, dev.LoadInfo(context.Background()))
+
+	f.handle("GetDeviceInformation", func(w http.ResponseWriter, r *http.Request) {
+		writeFault(w, http.StatusBadRequest, "env:Sender", "ter:NotAuthorized", "Sender not Authorized")
+	})
+	assert.Error(t, dev.LoadInfo(context.Background()))
+	assert.Equal(t, "ACME", dev.GetDeviceInfo().Manufacturer)
+}
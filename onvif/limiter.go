@@ -0,0 +1,156 @@
+package onvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/networking"
+)
+
+var (
+	// ErrQueueFull is returned when too many requests already wait to be sent to the device
+	ErrQueueFull = errors.New("too many requests queued for the device")
+	// ErrQueueTimeout is returned when a request waited longer than ConcurrencyLimit.WaitTimeout
+	ErrQueueTimeout = errors.New("timeout waiting to send the request to the device")
+)
+
+// ConcurrencyLimit bounds the requests sent at once to a device, for the
+// devices failing under concurrent requests
+type ConcurrencyLimit struct {
+	// MaxInFlight is the number of requests sent at once, 1 serializes them
+	MaxInFlight int
+	// QueueDepth is the number of requests allowed to wait for their turn,
+	// unbounded when 0. Beyond it, the requests fail with ErrQueueFull.
+	QueueDepth int
+	// WaitTimeout bounds the wait of each request, beyond it the request fails
+	// with ErrQueueTimeout. Only the context bounds the wait when 0.
+	WaitTimeout time.Duration
+	// OnWait is called with the time each request waited for its turn, if set
+	OnWait func(operation string, wait time.Duration)
+}
+
+// QueueStats describes the use of the queue of a device
+type QueueStats struct {
+	InFlight int
+	Waiting  int
+	// Sent is the number of requests that got their turn
+	Sent uint64
+	// Rejected is the number of requests failed with ErrQueueFull or ErrQueueTimeout
+	Rejected  uint64
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// limiter is shared by all the copies of a Device
+type limiter struct {
+	limit ConcurrencyLimit
+	slots chan struct{}
+
+	mu    sync.Mutex
+	stats QueueStats
+}
+
+func newLimiter(limit ConcurrencyLimit) *limiter {
+	if limit.MaxInFlight < 1 {
+		limit.MaxInFlight = 1
+	}
+	return &limiter{limit: limit, slots: make(chan struct{}, limit.MaxInFlight)}
+}
+
+// QueueStats returns the statistics of the queue of the device, zero when
+// DeviceParams.Concurrency is not set
+func (dev *Device) QueueStats() QueueStats {
+	if dev.limiter == nil {
+		return QueueStats{}
+	}
+	dev.limiter.mu.Lock()
+	defer dev.limiter.mu.Unlock()
+	stats := dev.limiter.stats
+	stats.InFlight = len(dev.limiter.slots)
+	return stats
+}
+
+func (l *limiter) acquire(ctx context.Context, operation string) error {
+	l.mu.Lock()
+	if l.limit.QueueDepth > 0 && l.stats.Waiting >= l.limit.QueueDepth && len(l.slots) == cap(l.slots) {
+		l.stats.Rejected++
+		l.mu.Unlock()
+		return ErrQueueFull
+	}
+	l.stats.Waiting++
+	l.mu.Unlock()
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if l.limit.WaitTimeout > 0 {
+		timer := time.NewTimer(l.limit.WaitTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+
+	var err error
+	select {
+	case l.slots <- struct{}{}:
+	case <-ctx.Done():
+		err = ctx.Err()
+	case <-timeout:
+		err = ErrQueueTimeout
+	}
+	wait := time.Since(start)
+
+	l.mu.Lock()
+	l.stats.Waiting--
+	switch {
+	case err == nil:
+		l.stats.Sent++
+		l.stats.TotalWait += wait
+		if wait > l.stats.MaxWait {
+			l.stats.MaxWait = wait
+		}
+	case err == ErrQueueTimeout:
+		l.stats.Rejected++
+	}
+	l.mu.Unlock()
+
+	if err == nil && l.limit.OnWait != nil {
+		l.limit.OnWait(operation, wait)
+	}
+	return err
+}
+
+func (l *limiter) release() {
+	<-l.slots
+}
+
+// middleware holds a slot of l from the sending of each request until the
+// body of its reply is closed
+func (l *limiter) middleware(next networking.Transport) networking.Transport {
+	return networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+		if err := l.acquire(ctx, req.Operation); err != nil {
+			return nil, err
+		}
+		resp, err := next.RoundTrip(ctx, req)
+		if err != nil {
+			l.release()
+			return nil, err
+		}
+		resp.Body = &releasingBody{ReadCloser: resp.Body, release: l.release}
+		return resp, nil
+	})
+}
+
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
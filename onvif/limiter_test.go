@@ -0,0 +1,95 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/media"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConcurrencyLimit(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", profiles)
+	var waited []string
+	dev := f.device(t, DeviceParams{Concurrency: &ConcurrencyLimit{
+		MaxInFlight: 1,
+		QueueDepth:  1,
+		OnWait:      func(operation string, wait time.Duration) { waited = append(waited, operation) },
+	}})
+
+	// The slot is held until the body of the reply is closed
+	first, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, 1, dev.QueueStats().InFlight)
+
+	second := make(chan error, 1)
+	go func() {
+		resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+		if err == nil {
+			resp.Body.Close()
+		}
+		second <- err
+	}()
+	require.Eventually(t, func() bool { return dev.QueueStats().Waiting == 1 }, time.Second, time.Millisecond)
+	assert.Len(t, f.received(), 1, "the second request waits for its turn")
+
+	_, err = dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	assert.ErrorIs(t, err, ErrQueueFull)
+
+	readAll(t, first)
+	require.NoError(t, <-second)
+	assert.Len(t, f.received(), 2)
+
+	stats := dev.QueueStats()
+	assert.Equal(t, 0, stats.InFlight)
+	assert.Equal(t, 0, stats.Waiting)
+	assert.Equal(t, uint64(2), stats.Sent)
+	assert.Equal(t, uint64(1), stats.Rejected)
+	assert.Greater(t, stats.MaxWait, time.Duration(0))
+	assert.Equal(t, []string{"GetProfiles", "GetProfiles"}, waited)
+}
+
+func TestConcurrencyLimitTimeout(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", profiles)
+	dev := f.device(t, DeviceParams{Concurrency: &ConcurrencyLimit{MaxInFlight: 1, WaitTimeout: 50 * time.Millisecond}})
+
+	first, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	defer readAll(t, first)
+
+	_, err = dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	assert.ErrorIs(t, err, ErrQueueTimeout)
+
+	// The context bounds the wait too, without counting as a rejection
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+	defer cancel()
+	_, err = dev.CallMethodContext(ctx, media.GetProfiles{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	assert.Equal(t, uint64(1), dev.QueueStats().Rejected)
+	assert.Len(t, f.received(), 1)
+}
+
+func TestConcurrencyLimitSharedByCopies(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", profiles)
+	dev := f.device(t, DeviceParams{Concurrency: &ConcurrencyLimit{MaxInFlight: 1, WaitTimeout: 10 * time.Millisecond}})
+	copied := *dev
+
+	resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	_, err = copied.CallMethodContext(context.Background(), media.GetProfiles{})
+	assert.ErrorIs(t, err, ErrQueueTimeout)
+	readAll(t, resp)
+
+	resp, err = copied.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+}
@@ -0,0 +1,145 @@
+package onvif
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	xsdonvif "github.com/BalkarSandhu/go-onvif/xsd/onvif"
+
+	"github.com/beevik/etree"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeMTOM answers with an MTOM message, whose root is a SOAP 1.2 envelope
+// whose Body holds body
+func writeMTOM(w http.ResponseWriter, body string, attachments ...gosoap.Attachment) {
+	reader, contentType, err := gosoap.NewMTOMBody(testEnvelopeStart+body+testEnvelopeEnd, "application/soap+xml; charset=utf-8", attachments)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	io.Copy(w, reader)
+}
+
+// readUpload returns the content of the attachment referenced by the element
+// at path of the MTOM request r
+func readUpload(r *http.Request, path string) (string, error) {
+	m, err := gosoap.NewMTOMReader(r.Header.Get("Content-Type"), r.Body)
+	if err != nil {
+		return "", err
+	}
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(m.Envelope); err != nil {
+		return "", err
+	}
+	include := doc.FindElement(path + "/Include")
+	if include == nil {
+		return "", io.ErrUnexpectedEOF
+	}
+	content := m.Open(include.SelectAttrValue("href", ""))
+	defer content.Close()
+	data, err := io.ReadAll(content)
+	return string(data), err
+}
+
+// upgradeFirmware answers UpgradeSystemFirmware with the content of the firmware
+func upgradeFirmware(w http.ResponseWriter, r *http.Request) {
+	firmware, err := readUpload(r, "//UpgradeSystemFirmware/Firmware")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	writeEnvelope(w, http.StatusOK, `<tds:UpgradeSystemFirmwareResponse><tds:Message>`+firmware+`</tds:Message></tds:UpgradeSystemFirmwareResponse>`)
+}
+
+func firmware(content string) device.UpgradeSystemFirmware {
+	return device.UpgradeSystemFirmware{Firmware: xsdonvif.AttachmentData{Content: strings.NewReader(content)}}
+}
+
+func TestMTOMUpload(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("UpgradeSystemFirmware", upgradeFirmware)
+	dev := f.device(t, DeviceParams{})
+
+	resp, err := dev.CallMethodContext(context.Background(), firmware("firmware 2.0"))
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, readAll(t, resp), "<tds:Message>firmware 2.0</tds:Message>")
+	assert.Equal(t, []string{"UpgradeSystemFirmware"}, f.operations())
+	assert.True(t, gosoap.IsMTOM(f.received()[0].Header.Get("Content-Type")))
+}
+
+func TestMTOMUploadWithDigest(t *testing.T) {
+	for _, mode := range []AuthMode{AuthDigest, AuthAuto} {
+		t.Run(mode.String(), func(t *testing.T) {
+			f := newFakeDevice(t)
+			f.handle("GetServiceCapabilities", requireDigest("admin", "secret", func(w http.ResponseWriter, r *http.Request) {
+				writeEnvelope(w, http.StatusOK, `<tds:GetServiceCapabilitiesResponse/>`)
+			}))
+			f.handle("UpgradeSystemFirmware", requireDigest("admin", "secret", upgradeFirmware))
+			dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", AuthMode: mode})
+
+			// The challenge is fetched before the attachment is streamed
+			resp, err := dev.CallMethodContext(context.Background(), firmware("firmware 2.0"))
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Contains(t, readAll(t, resp), "firmware 2.0")
+			assert.Equal(t, []string{"GetServiceCapabilities", "GetServiceCapabilities", "UpgradeSystemFirmware"}, f.operations())
+			assert.Equal(t, "http://www.onvif.org/ver10/device/wsdl", f.received()[0].Envelope.FindElement("//GetServiceCapabilities").NamespaceURI())
+
+			// Then reused
+			resp, err = dev.CallMethodContext(context.Background(), firmware("firmware 2.1"))
+			require.NoError(t, err)
+			assert.Contains(t, readAll(t, resp), "firmware 2.1")
+			assert.Len(t, f.received(), 4)
+		})
+	}
+}
+
+func TestMTOMUploadWithoutNegotiation(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("UpgradeSystemFirmware", upgradeFirmware)
+
+	// WS-Security and a fixed version of SOAP need no probe
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", AuthMode: AuthWSSecurity, SOAPVersion: SOAP12})
+	resp, err := dev.CallMethodContext(context.Background(), firmware("firmware 2.0"))
+	require.NoError(t, err)
+	readAll(t, resp)
+	assert.Equal(t, []string{"UpgradeSystemFirmware"}, f.operations())
+	assert.True(t, hasUsernameToken(f.received()[0]))
+}
+
+func TestMTOMReplyTrace(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetSystemBackup", func(w http.ResponseWriter, r *http.Request) {
+		writeMTOM(w, `<tds:GetSystemBackupResponse><tds:BackupFiles><tt:Name>config</tt:Name>`+
+			`<tt:Data><xop:Include xmlns:xop="http://www.w3.org/2004/08/xop/include" href="cid:config@camera"/></tt:Data>`+
+			`</tds:BackupFiles></tds:GetSystemBackupResponse>`,
+			gosoap.Attachment{ContentID: "config@camera", ContentType: "application/octet-stream", Content: strings.NewReader("backup")})
+	})
+	var events []TraceEvent
+	dev := f.device(t, DeviceParams{Trace: func(event TraceEvent) { events = append(events, event) }})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetSystemBackup{})
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Len(t, events, 1)
+	assert.Empty(t, events[0].Response, "the MTOM reply is not buffered")
+	assert.True(t, gosoap.IsMTOM(events[0].ContentType))
+	assert.Equal(t, http.StatusOK, events[0].StatusCode)
+
+	m, err := gosoap.NewMTOMReader(resp.Header.Get("Content-Type"), resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(m.Envelope), "GetSystemBackupResponse")
+	content, err := io.ReadAll(m.Open("cid:config@camera"))
+	require.NoError(t, err)
+	assert.Equal(t, "backup", string(content))
+}
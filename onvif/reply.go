@@ -0,0 +1,85 @@
+package onvif
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/networking"
+)
+
+// DefaultMaxResponseSize bounds the replies of the devices when
+// DeviceParams.MaxResponseSize is not set
+const DefaultMaxResponseSize = 16 << 20
+
+// ErrResponseTooLarge is returned when reading a reply beyond DeviceParams.MaxResponseSize
+var ErrResponseTooLarge = errors.New("reply of the device exceeds the maximum response size")
+
+type streamedAttachmentsKey struct{}
+
+// WithStreamedAttachments returns a copy of ctx telling that the caller
+// streams the attachments of the MTOM replies, and bounds by itself the
+// parts it holds in memory (see gosoap.NewMTOMReaderLimit). The MTOM
+// replies to the calls made with it are not bounded as a whole.
+func WithStreamedAttachments(ctx context.Context) context.Context {
+	return context.WithValue(ctx, streamedAttachmentsKey{}, true)
+}
+
+// MaxResponseSize returns the bound of the size of the replies of the device,
+// negative when they are not bounded
+func (dev *Device) MaxResponseSize() int64 {
+	if dev.params.MaxResponseSize == 0 {
+		return DefaultMaxResponseSize
+	}
+	return dev.params.MaxResponseSize
+}
+
+// boundingReplies makes the bodies of the replies fail with ErrResponseTooLarge
+// beyond max bytes, but the MTOM replies whose attachments are streamed
+func boundingReplies(max int64) networking.Middleware {
+	return func(next networking.Transport) networking.Transport {
+		return networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+			resp, err := next.RoundTrip(ctx, req)
+			if err != nil {
+				return nil, err
+			}
+			if streamed, _ := ctx.Value(streamedAttachmentsKey{}).(bool); streamed && gosoap.IsMTOM(resp.Header.Get("Content-Type")) {
+				return resp, nil
+			}
+			if resp.ContentLength > max {
+				resp.Body.Close()
+				return nil, ErrResponseTooLarge
+			}
+			resp.Body = &boundedBody{ReadCloser: resp.Body, remaining: max}
+			return resp, nil
+		})
+	}
+}
+
+type boundedBody struct {
+	io.ReadCloser
+	remaining int64
+}
+
+func (b *boundedBody) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if b.remaining <= 0 {
+		// Tell the end of the body from a body that is too large
+		var probe [1]byte
+		n, err := b.ReadCloser.Read(probe[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+	if int64(len(p)) > b.remaining {
+		p = p[:b.remaining]
+	}
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,75 @@
+package onvif
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/media"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// largeProfiles answers GetProfiles with a reply of about size bytes, chunked
+// unless length is set
+func largeProfiles(size int, length bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body := `<trt:GetProfilesResponse>` + strings.Repeat(" ", size) + `</trt:GetProfilesResponse>`
+		if !length {
+			w.Header().Set("Transfer-Encoding", "chunked")
+		}
+		writeEnvelope(w, http.StatusOK, body)
+	}
+}
+
+func TestMaxResponseSize(t *testing.T) {
+	for _, length := range []bool{true, false} {
+		f := newFakeDevice(t)
+		f.handle("GetProfiles", largeProfiles(4096, length))
+		dev := f.device(t, DeviceParams{MaxResponseSize: 2048})
+		assert.Equal(t, int64(2048), dev.MaxResponseSize())
+
+		resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+		if err == nil {
+			_, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+		}
+		assert.ErrorIs(t, err, ErrResponseTooLarge)
+
+		// Unbounded
+		dev = f.device(t, DeviceParams{MaxResponseSize: -1})
+		resp, err = dev.CallMethodContext(context.Background(), media.GetProfiles{})
+		require.NoError(t, err)
+		assert.Greater(t, len(readAll(t, resp)), 4096)
+	}
+
+	dev, err := NewDeviceOffline(DeviceParams{Xaddr: "http://camera"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(DefaultMaxResponseSize), dev.MaxResponseSize())
+}
+
+func TestMaxResponseSizeMTOM(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetSystemBackup", func(w http.ResponseWriter, r *http.Request) {
+		writeMTOM(w, `<tds:GetSystemBackupResponse/>`,
+			gosoap.Attachment{ContentID: "backup@camera", ContentType: "application/octet-stream", Content: strings.NewReader(strings.Repeat("b", 4096))})
+	})
+	dev := f.device(t, DeviceParams{MaxResponseSize: 2048})
+
+	// The MTOM replies are bounded as a whole
+	resp, err := dev.CallMethodContext(context.Background(), device.GetSystemBackup{})
+	require.NoError(t, err)
+	_, err = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.ErrorIs(t, err, ErrResponseTooLarge)
+
+	// Unless the caller streams their attachments
+	resp, err = dev.CallMethodContext(WithStreamedAttachments(context.Background()), device.GetSystemBackup{})
+	require.NoError(t, err)
+	assert.Greater(t, len(readAll(t, resp)), 4096)
+}
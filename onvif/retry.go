@@ -0,0 +1,136 @@
+package onvif
+
+import (
+	"context"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/networking"
+)
+
+// RetryPolicy configures how the requests failing at the transport level
+// (e.g. a connection dropped by the device after an idle period) are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry. Each subsequent delay
+	// is multiplied by Multiplier, up to MaxBackoff.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Multiplier     float64
+	// Jitter is the fraction of each delay that is randomized, between 0 and 1
+	Jitter float64
+	// Retryable tells if an operation may be sent twice.
+	// IsReadOnlyOperation is used when nil.
+	Retryable func(operation string) bool
+}
+
+// DefaultRetryPolicy retries the read-only operations up to 3 times
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     2 * time.Second,
+	Multiplier:     2,
+	Jitter:         0.2,
+}
+
+// IsReadOnlyOperation tells if the ONVIF operation only reads the state of the
+// device (GetProfiles, GetStatus, ...), and thus may safely be sent again.
+// The Get operations with side effects or a high cost are excluded.
+func IsReadOnlyOperation(operation string) bool {
+	return strings.HasPrefix(operation, "Get") && !unsafeGetOperations[operation]
+}
+
+// unsafeGetOperations are the Get operations that must not be sent twice
+var unsafeGetOperations = map[string]bool{
+	// Generate a key pair, a backup or the files of the device
+	"GetPkcs10Request":            true,
+	"GetSystemBackup":             true,
+	"GetSystemUris":               true,
+	"GetSystemSupportInformation": true,
+	"GetSystemLog":                true,
+	// Consume the results of a search session
+	"GetRecordingSearchResults":   true,
+	"GetEventSearchResults":       true,
+	"GetPTZPositionSearchResults": true,
+	"GetMetadataSearchResults":    true,
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a copy of ctx overriding the retry policy of the
+// device for the calls made with it
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, &policy)
+}
+
+// WithoutRetry returns a copy of ctx disabling the retries for the calls made with it
+func WithoutRetry(ctx context.Context) context.Context {
+	return WithRetryPolicy(ctx, RetryPolicy{MaxAttempts: 1})
+}
+
+func (p *RetryPolicy) retryable(operation string) bool {
+	if p.Retryable != nil {
+		return p.Retryable(operation)
+	}
+	return IsReadOnlyOperation(operation)
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	delay := float64(p.InitialBackoff)
+	for i := 1; i < retry; i++ {
+		if p.Multiplier > 1 {
+			delay *= p.Multiplier
+		}
+		if p.MaxBackoff > 0 && delay > float64(p.MaxBackoff) {
+			delay = float64(p.MaxBackoff)
+			break
+		}
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	return time.Duration(delay)
+}
+
+// retrying is the middleware applying the retry policy of the context, or
+// the one of the device
+func retrying(policy *RetryPolicy) networking.Middleware {
+	return func(next networking.Transport) networking.Transport {
+		return networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+			p := policy
+			if override, ok := ctx.Value(retryPolicyKey{}).(*RetryPolicy); ok {
+				p = override
+			}
+			// A streamed body cannot be sent twice
+			if p == nil || p.MaxAttempts <= 1 || req.Body != nil || !p.retryable(req.Operation) {
+				return next.RoundTrip(ctx, req)
+			}
+
+			for attempt := 1; ; attempt++ {
+				resp, err := next.RoundTrip(ctx, req)
+				if ctx.Err() != nil || attempt >= p.MaxAttempts {
+					return resp, err
+				}
+				if err == nil && resp.StatusCode != http.StatusServiceUnavailable {
+					return resp, nil
+				}
+				if resp != nil {
+					io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+					resp.Body.Close()
+				}
+
+				timer := time.NewTimer(p.backoff(attempt))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return nil, ctx.Err()
+				case <-timer.C:
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/media"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond, Multiplier: 2}
+
+// flaky fails the first failures requests, with a 503 or a dropped connection
+func flaky(failures int32, drop bool, next http.HandlerFunc) http.HandlerFunc {
+	var count atomic.Int32
+	return func(w http.ResponseWriter, r *http.Request) {
+		if count.Add(1) <= failures {
+			if drop {
+				conn, _, err := w.(http.Hijacker).Hijack()
+				if err == nil {
+					conn.Close()
+				}
+				return
+			}
+			http.Error(w, "busy", http.StatusServiceUnavailable)
+			return
+		}
+		next(w, r)
+	}
+}
+
+func profiles(w http.ResponseWriter, r *http.Request) {
+	writeEnvelope(w, http.StatusOK, `<trt:GetProfilesResponse/>`)
+}
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	assert.True(t, IsReadOnlyOperation("GetProfiles"))
+	assert.True(t, IsReadOnlyOperation("GetStatus"))
+	assert.False(t, IsReadOnlyOperation("SetImagingSettings"))
+	assert.False(t, IsReadOnlyOperation("ContinuousMove"))
+	assert.False(t, IsReadOnlyOperation("GetPkcs10Request"))
+	assert.False(t, IsReadOnlyOperation("GetSystemBackup"))
+	assert.False(t, IsReadOnlyOperation("GetSystemUris"))
+	assert.False(t, IsReadOnlyOperation("GetRecordingSearchResults"))
+}
+
+func TestRetryUnavailable(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", flaky(2, false, profiles))
+	dev := f.device(t, DeviceParams{Retry: &fastRetry})
+
+	resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), 3)
+}
+
+func TestRetryDroppedConnection(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", flaky(1, true, profiles))
+	dev := f.device(t, DeviceParams{Retry: &fastRetry})
+
+	resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), 2)
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", flaky(10, false, profiles))
+	dev := f.device(t, DeviceParams{Retry: &fastRetry})
+
+	resp, err := dev.CallMethodContext(context.Background(), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), fastRetry.MaxAttempts)
+}
+
+func TestRetrySkipsUnsafeOperations(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("SystemReboot", flaky(1, false, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, `<tds:SystemRebootResponse/>`)
+	}))
+	f.handle("GetSystemBackup", flaky(1, false, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, `<tds:GetSystemBackupResponse/>`)
+	}))
+	dev := f.device(t, DeviceParams{Retry: &fastRetry})
+
+	for _, method := range []interface{}{device.SystemReboot{}, device.GetSystemBackup{}} {
+		resp, err := dev.CallMethodContext(context.Background(), method)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		readAll(t, resp)
+	}
+	assert.Equal(t, []string{"SystemReboot", "GetSystemBackup"}, f.operations())
+}
+
+func TestRetryPolicyOfContext(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", flaky(1, false, profiles))
+	dev := f.device(t, DeviceParams{Retry: &fastRetry})
+
+	resp, err := dev.CallMethodContext(WithoutRetry(context.Background()), media.GetProfiles{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), 1)
+
+	// A device without policy retries with the one of the context
+	f = newFakeDevice(t)
+	f.handle("SetImagingSettings", flaky(1, false, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, `<timg:SetImagingSettingsResponse xmlns:timg="http://www.onvif.org/ver20/imaging/wsdl"/>`)
+	}))
+	dev = f.device(t, DeviceParams{})
+	policy := fastRetry
+	policy.Retryable = func(operation string) bool { return operation == "SetImagingSettings" }
+	resp, err = dev.callOperation(WithRetryPolicy(context.Background(), policy), dev.GetEndpoint("imaging"), nil,
+		"SetImagingSettings", `<timg:SetImagingSettings xmlns:timg="http://www.onvif.org/ver20/imaging/wsdl"/>`, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+	assert.Len(t, f.received(), 2)
+}
+
+func TestRetryBackoffHonorsContext(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetProfiles", flaky(10, false, profiles))
+	slow := RetryPolicy{MaxAttempts: 5, InitialBackoff: time.Hour}
+	dev := f.device(t, DeviceParams{Retry: &slow})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := dev.CallMethodContext(ctx, media.GetProfiles{})
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Len(t, f.received(), 1)
+}
+
+func TestRetryBackoff(t *testing.T) {
+	p := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond, Multiplier: 2}
+	assert.Equal(t, 100*time.Millisecond, p.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, p.backoff(2))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(3))
+	assert.Equal(t, 300*time.Millisecond, p.backoff(10))
+
+	p.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := p.backoff(1)
+		assert.GreaterOrEqual(t, delay, 50*time.Millisecond)
+		assert.LessOrEqual(t, delay, 150*time.Millisecond)
+	}
+}
@@ -0,0 +1,245 @@
+package onvif
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+
+	"github.com/beevik/etree"
+)
+
+// Namespaces of the ONVIF services
+const (
+	DeviceNamespace           = "http://www.onvif.org/ver10/device/wsdl"
+	MediaNamespace            = "http://www.onvif.org/ver10/media/wsdl"
+	Media2Namespace           = "http://www.onvif.org/ver20/media/wsdl"
+	EventsNamespace           = "http://www.onvif.org/ver10/events/wsdl"
+	PTZNamespace              = "http://www.onvif.org/ver20/ptz/wsdl"
+	ImagingNamespace          = "http://www.onvif.org/ver20/imaging/wsdl"
+	AnalyticsNamespace        = "http://www.onvif.org/ver20/analytics/wsdl"
+	AnalyticsDeviceNamespace  = "http://www.onvif.org/ver10/analyticsdevice/wsdl"
+	DeviceIONamespace         = "http://www.onvif.org/ver10/deviceIO/wsdl"
+	DisplayNamespace          = "http://www.onvif.org/ver10/display/wsdl"
+	RecordingNamespace        = "http://www.onvif.org/ver10/recording/wsdl"
+	SearchNamespace           = "http://www.onvif.org/ver10/search/wsdl"
+	ReplayNamespace           = "http://www.onvif.org/ver10/replay/wsdl"
+	ReceiverNamespace         = "http://www.onvif.org/ver10/receiver/wsdl"
+	ThermalNamespace          = "http://www.onvif.org/ver10/thermal/wsdl"
+	AccessControlNamespace    = "http://www.onvif.org/ver10/accesscontrol/wsdl"
+	AccessRulesNamespace      = "http://www.onvif.org/ver10/accessrules/wsdl"
+	DoorControlNamespace      = "http://www.onvif.org/ver10/doorcontrol/wsdl"
+	CredentialNamespace       = "http://www.onvif.org/ver10/credential/wsdl"
+	ScheduleNamespace         = "http://www.onvif.org/ver10/schedule/wsdl"
+	ActionEngineNamespace     = "http://www.onvif.org/ver10/actionengine/wsdl"
+	AdvancedSecurityNamespace = "http://www.onvif.org/ver10/advancedsecurity/wsdl"
+	ProvisioningNamespace     = "http://www.onvif.org/ver10/provisioning/wsdl"
+)
+
+// serviceKeys maps the namespaces of the services to the keys of their endpoints.
+// The keys match the ones derived from the categories of GetCapabilities.
+var serviceKeys = map[string]string{
+	DeviceNamespace:           "device",
+	MediaNamespace:            "media",
+	Media2Namespace:           "media2",
+	EventsNamespace:           "events",
+	PTZNamespace:              "ptz",
+	ImagingNamespace:          "imaging",
+	AnalyticsNamespace:        "analytics",
+	AnalyticsDeviceNamespace:  "analyticsdevice",
+	DeviceIONamespace:         "deviceio",
+	DisplayNamespace:          "display",
+	RecordingNamespace:        "recording",
+	SearchNamespace:           "search",
+	ReplayNamespace:           "replay",
+	ReceiverNamespace:         "receiver",
+	ThermalNamespace:          "thermal",
+	AccessControlNamespace:    "accesscontrol",
+	AccessRulesNamespace:      "accessrules",
+	DoorControlNamespace:      "doorcontrol",
+	CredentialNamespace:       "credential",
+	ScheduleNamespace:         "schedule",
+	ActionEngineNamespace:     "actionengine",
+	AdvancedSecurityNamespace: "advancedsecurity",
+	ProvisioningNamespace:     "provisioning",
+}
+
+// Version of a service implemented by a device (not the version of the ONVIF Core specification)
+type Version struct {
+	Major int
+	Minor int
+}
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// AtLeast tells if the version is greater or equal to major.minor
+func (v Version) AtLeast(major, minor int) bool {
+	return v.Major > major || (v.Major == major && v.Minor >= minor)
+}
+
+// ServiceInfo describes a service advertised by the device.
+// The Version is zero when the device only advertised the service with GetCapabilities.
+type ServiceInfo struct {
+	Namespace string
+	XAddr     string
+	Version   Version
+}
+
+// SupportedServices returns the services advertised by the device, by namespace
+func (dev *Device) SupportedServices() map[string]ServiceInfo {
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
+	services := make(map[string]ServiceInfo, len(dev.dir.services))
+	for k, v := range dev.dir.services {
+		services[k] = v
+	}
+	return services
+}
+
+// ServiceVersion returns the version of the service with the given namespace,
+// and false if the device does not support the service.
+func (dev *Device) ServiceVersion(namespace string) (Version, bool) {
+	dev.dir.RLock()
+	defer dev.dir.RUnlock()
+	service, ok := dev.dir.services[namespace]
+	return service.Version, ok
+}
+
+// serviceKey returns the key of the endpoint of the service with the given namespace
+func serviceKey(namespace string) string {
+	if key, ok := serviceKeys[namespace]; ok {
+		return key
+	}
+
+	// Other ONVIF services are named after the path of their namespace,
+	// e.g. http://www.onvif.org/ver10/uplink/wsdl
+	if u, err := url.Parse(namespace); err == nil && u.Host == "www.onvif.org" {
+		parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(parts) == 3 && parts[2] == "wsdl" {
+			return strings.ToLower(parts[1])
+		}
+	}
+	return namespace
+}
+
+// discoverServices fills the endpoints of the device with GetServices, and
+// falls back on GetCapabilities for the devices that predate GetServices.
+func (dev *Device) discoverServices(ctx context.Context) error {
+	err := dev.discoverWithGetServices(ctx)
+	if err == nil {
+		return nil
+	}
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+
+	getCapabilities := device.GetCapabilities{Category: "All"}
+
+	resp, err := dev.CallMethodContext(ctx, getCapabilities)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return ctxErr
+	}
+	if err == nil && resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+	}
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return errors.New("camera is not available at " + dev.params.Xaddr + " or it does not support ONVIF services")
+	}
+
+	return dev.getSupportedServices(resp)
+}
+
+func (dev *Device) discoverWithGetServices(ctx context.Context) error {
+	resp, err := dev.CallMethodContext(ctx, device.GetServices{IncludeCapability: true})
+	if err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if err = gosoap.FaultFromReply(resp.StatusCode, data); err != nil {
+		return err
+	}
+
+	doc := etree.NewDocument()
+	if err := doc.ReadFromBytes(data); err != nil {
+		return err
+	}
+
+	services := doc.FindElements("./Envelope/Body/GetServicesResponse/Service")
+	if len(services) == 0 {
+		return errors.New("no service advertised")
+	}
+
+	for _, s := range services {
+		info := ServiceInfo{
+			Namespace: strings.TrimSpace(elementText(s, "Namespace")),
+			XAddr:     strings.TrimSpace(elementText(s, "XAddr")),
+		}
+		info.Version.Major, _ = strconv.Atoi(strings.TrimSpace(elementText(s, "Version/Major")))
+		info.Version.Minor, _ = strconv.Atoi(strings.TrimSpace(elementText(s, "Version/Minor")))
+		if info.Namespace == "" || info.XAddr == "" {
+			continue
+		}
+
+		key := serviceKey(info.Namespace)
+		dev.addEndpoint(key, info.XAddr)
+		info.XAddr = dev.GetEndpoint(key)
+		dev.dir.Lock()
+		dev.dir.services[info.Namespace] = info
+		// The Capabilities element of the service, requested with IncludeCapability
+		if c := s.FindElement("Capabilities/*"); c != nil {
+			dev.dir.advertised[info.Namespace] = c.Copy()
+		}
+		dev.dir.Unlock()
+	}
+	return nil
+}
+
+// capabilityNamespaces maps the categories of GetCapabilities to the namespaces of the services
+var capabilityNamespaces = map[string]string{
+	"analytics":       AnalyticsNamespace,
+	"device":          DeviceNamespace,
+	"events":          EventsNamespace,
+	"imaging":         ImagingNamespace,
+	"media":           MediaNamespace,
+	"ptz":             PTZNamespace,
+	"deviceio":        DeviceIONamespace,
+	"display":         DisplayNamespace,
+	"recording":       RecordingNamespace,
+	"search":          SearchNamespace,
+	"replay":          ReplayNamespace,
+	"receiver":        ReceiverNamespace,
+	"analyticsdevice": AnalyticsDeviceNamespace,
+}
+
+// addCapabilityService records a service advertised by GetCapabilities, without version
+func (dev *Device) addCapabilityService(category string) {
+	if ns, ok := capabilityNamespaces[strings.ToLower(category)]; ok {
+		xaddr := dev.GetEndpoint(category)
+		dev.dir.Lock()
+		if _, known := dev.dir.services[ns]; !known {
+			dev.dir.services[ns] = ServiceInfo{Namespace: ns, XAddr: xaddr}
+		}
+		dev.dir.Unlock()
+	}
+}
+
+func elementText(e *etree.Element, path string) string {
+	if child := e.FindElement(path); child != nil {
+		return child.Text()
+	}
+	return ""
+}
@@ -0,0 +1,108 @@
+package onvif
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const getServices = `<tds:GetServicesResponse>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver10/device/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/device_service</tds:XAddr>
+		<tds:Version><tt:Major>21</tt:Major><tt:Minor>12</tt:Minor></tds:Version>
+	</tds:Service>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver10/media/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/media_service</tds:XAddr>
+		<tds:Version><tt:Major>2</tt:Major><tt:Minor>60</tt:Minor></tds:Version>
+	</tds:Service>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver20/media/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/media2_service</tds:XAddr>
+		<tds:Version><tt:Major>18</tt:Major><tt:Minor>6</tt:Minor></tds:Version>
+	</tds:Service>
+	<tds:Service>
+		<tds:Namespace>http://www.onvif.org/ver10/uplink/wsdl</tds:Namespace>
+		<tds:XAddr>http://192.168.0.10/onvif/uplink_service</tds:XAddr>
+		<tds:Version><tt:Major>1</tt:Major><tt:Minor>0</tt:Minor></tds:Version>
+	</tds:Service>
+</tds:GetServicesResponse>`
+
+const getCapabilities = `<tds:GetCapabilitiesResponse><tds:Capabilities>
+	<tt:Device><tt:XAddr>http://192.168.0.10/onvif/device_service</tt:XAddr></tt:Device>
+	<tt:Media><tt:XAddr>http://192.168.0.10/onvif/Media</tt:XAddr></tt:Media>
+	<tt:PTZ><tt:XAddr>http://192.168.0.10/onvif/PTZ</tt:XAddr></tt:PTZ>
+	<tt:Extension>
+		<tt:Recording><tt:XAddr>http://192.168.0.10/onvif/Recording</tt:XAddr></tt:Recording>
+	</tt:Extension>
+</tds:Capabilities></tds:GetCapabilitiesResponse>`
+
+func TestDiscoverWithGetServices(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetServices", getServices)
+
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	assert.NotContains(t, f.operations(), "GetCapabilities")
+
+	// The advertised private address is replaced by the one the device is reached at
+	assert.Equal(t, f.URL+"/onvif/media_service", dev.GetEndpoint("media"))
+	assert.Equal(t, f.URL+"/onvif/media2_service", dev.GetEndpoint("media2"))
+	assert.Equal(t, f.URL+"/onvif/uplink_service", dev.GetEndpoint("uplink"))
+
+	version, ok := dev.ServiceVersion(Media2Namespace)
+	require.True(t, ok)
+	assert.Equal(t, Version{Major: 18, Minor: 6}, version)
+	assert.True(t, version.AtLeast(18, 6))
+	assert.False(t, version.AtLeast(18, 12))
+	assert.Equal(t, "18.6", version.String())
+
+	_, ok = dev.ServiceVersion(PTZNamespace)
+	assert.False(t, ok)
+	assert.Len(t, dev.SupportedServices(), 4)
+
+	// media does not resolve to the endpoint of media2
+	endpoint, err := dev.getEndpoint("media")
+	require.NoError(t, err)
+	assert.Equal(t, f.URL+"/onvif/media_service", endpoint)
+}
+
+func TestDiscoverFallsBackOnGetCapabilities(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetCapabilities", getCapabilities)
+
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	assert.Contains(t, f.operations(), "GetServices")
+	assert.Contains(t, f.operations(), "GetCapabilities")
+
+	assert.Equal(t, f.URL+"/onvif/PTZ", dev.GetEndpoint("ptz"))
+	assert.Equal(t, f.URL+"/onvif/Recording", dev.GetEndpoint("recording"))
+
+	// The services are known, without version
+	version, ok := dev.ServiceVersion(PTZNamespace)
+	assert.True(t, ok)
+	assert.Equal(t, Version{}, version)
+	_, ok = dev.ServiceVersion(RecordingNamespace)
+	assert.True(t, ok)
+}
+
+func TestDiscoverFailure(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetCapabilities", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	_, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	assert.Error(t, err)
+}
+
+func TestServiceKey(t *testing.T) {
+	assert.Equal(t, "media2", serviceKey(Media2Namespace))
+	assert.Equal(t, "uplink", serviceKey("http://www.onvif.org/ver10/uplink/wsdl"))
+	assert.Equal(t, "http://vendor.example/wsdl", serviceKey("http://vendor.example/wsdl"))
+}
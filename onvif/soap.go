@@ -0,0 +1,135 @@
+package onvif
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+)
+
+// SOAPVersion selects the version of the SOAP envelopes sent to the device
+type SOAPVersion int
+
+// SOAP versions
+const (
+	// SOAP12 is the version required by ONVIF
+	SOAP12 SOAPVersion = iota
+	// SOAP11 is understood by some legacy encoders and NVRs only
+	SOAP11
+	// SOAPAuto starts with SOAP 1.2 and retries with SOAP 1.1 when the device
+	// rejects the envelope. Once SOAP 1.1 proved to be required, it is used
+	// for all the subsequent requests to the device.
+	SOAPAuto
+)
+
+func (v SOAPVersion) String() string {
+	switch v {
+	case SOAP12:
+		return "SOAP 1.2"
+	case SOAP11:
+		return "SOAP 1.1"
+	case SOAPAuto:
+		return "Auto"
+	default:
+		return strconv.Itoa(int(v))
+	}
+}
+
+// soapState is shared by all the copies of a Device. It keeps the version of
+// SOAP learned for the device.
+type soapState struct {
+	legacy atomic.Bool
+}
+
+// soapVersion resolves SOAPAuto into the version learned for the device
+func (dev Device) soapVersion() SOAPVersion {
+	if dev.params.SOAPVersion != SOAPAuto {
+		return dev.params.SOAPVersion
+	}
+	if dev.soap.legacy.Load() {
+		return SOAP11
+	}
+	return SOAP12
+}
+
+// newEnvelope returns an empty envelope of the given version
+func newEnvelope(version SOAPVersion) *gosoap.Envelope {
+	if version == SOAP11 {
+		return gosoap.NewEnvelope11()
+	}
+	return gosoap.NewEnvelope()
+}
+
+// soapHeader sets the HTTP headers expected with the given version: SOAP 1.1
+// is sent as text/xml, with the action in a SOAPAction header.
+func soapHeader(header http.Header, version SOAPVersion, action string) http.Header {
+	if version != SOAP11 {
+		return header
+	}
+	if header == nil {
+		header = make(http.Header)
+	}
+	header.Set("Content-Type", "text/xml; charset=utf-8")
+	header.Set("SOAPAction", strconv.Quote(action))
+	return header
+}
+
+// soapRetry tells if resp rejects the version of the envelope, and the request
+// deserves to be sent again with SOAP 1.1. The body of resp is closed when it
+// does, and left readable otherwise.
+func (dev Device) soapRetry(version SOAPVersion, resp *http.Response) bool {
+	if dev.params.SOAPVersion != SOAPAuto || version != SOAP12 {
+		return false
+	}
+	switch resp.StatusCode {
+	case http.StatusUnsupportedMediaType:
+	case http.StatusBadRequest, http.StatusInternalServerError:
+		fault, err := gosoap.ParseFault(peekBody(resp))
+		switch {
+		case err == nil && fault != nil:
+			// A genuine SOAP 1.2 fault is the answer to the request
+			if !gosoap.IsVersionMismatch(fault) {
+				return false
+			}
+		case resp.StatusCode != http.StatusInternalServerError:
+			return false
+		}
+	default:
+		return false
+	}
+
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	resp.Body.Close()
+	return true
+}
+
+// learnLegacySOAP remembers the device requires SOAP 1.1, if resp shows it
+// understood the SOAP 1.1 envelope: a success, or a fault other than a
+// VersionMismatch (SOAP 1.1 faults come with HTTP 500)
+func (dev Device) learnLegacySOAP(resp *http.Response) {
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+	case resp.StatusCode == http.StatusUnsupportedMediaType:
+		return
+	default:
+		fault, err := gosoap.ParseFault(peekBody(resp))
+		if err != nil || fault == nil || gosoap.IsVersionMismatch(fault) {
+			return
+		}
+	}
+	dev.soap.legacy.Store(true)
+}
+
+// peekBody returns the head of the body of resp, that is left readable from
+// its start. Faults are small, no more than 64KB is held in memory.
+func peekBody(resp *http.Response) []byte {
+	head, _ := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(head), resp.Body), resp.Body}
+	return head
+}
@@ -0,0 +1,110 @@
+package onvif
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	soap11Namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Namespace = "http://www.w3.org/2003/05/soap-envelope"
+)
+
+// legacySOAP answers as a device understanding SOAP 1.1 only, rejecting the
+// SOAP 1.2 envelopes with rejected
+func legacySOAP(rejected http.HandlerFunc, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Content-Type"), "text/xml") || r.Header.Get("SOAPAction") == "" {
+			rejected(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+		io.WriteString(w, `<s:Envelope xmlns:s="`+soap11Namespace+`" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"><s:Body>`+
+			body+`</s:Body></s:Envelope>`)
+	}
+}
+
+func unsupportedMediaType(w http.ResponseWriter, r *http.Request) {
+	http.Error(w, "unsupported media type", http.StatusUnsupportedMediaType)
+}
+
+func versionMismatch(w http.ResponseWriter, r *http.Request) {
+	writeEnvelope(w, http.StatusInternalServerError, `<env:Fault><env:Code><env:Value>env:VersionMismatch</env:Value></env:Code>`+
+		`<env:Reason><env:Text xml:lang="en">SOAP 1.1 only</env:Text></env:Reason></env:Fault>`)
+}
+
+// envelopeVersions returns the namespace of the envelope of each request received by f
+func envelopeVersions(f *fakeDevice) []string {
+	var versions []string
+	for _, req := range f.received() {
+		versions = append(versions, req.Envelope.Root().NamespaceURI())
+	}
+	return versions
+}
+
+func TestSOAPAutoFallback(t *testing.T) {
+	for name, rejected := range map[string]http.HandlerFunc{
+		"415":             unsupportedMediaType,
+		"VersionMismatch": versionMismatch,
+	} {
+		t.Run(name, func(t *testing.T) {
+			f := newFakeDevice(t)
+			f.handle("GetDeviceInformation", legacySOAP(rejected, deviceInformation))
+			dev := f.device(t, DeviceParams{SOAPVersion: SOAPAuto})
+
+			resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, resp.StatusCode)
+			assert.Contains(t, readAll(t, resp), "ACME")
+			assert.Equal(t, []string{soap12Namespace, soap11Namespace}, envelopeVersions(f))
+			assert.Equal(t, `"http://www.onvif.org/ver10/device/wsdl/GetDeviceInformation"`, f.received()[1].Header.Get("SOAPAction"))
+
+			// The version is learned, by the copies of the device too
+			copied := *dev
+			resp, err = copied.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+			require.NoError(t, err)
+			readAll(t, resp)
+			assert.Equal(t, []string{soap12Namespace, soap11Namespace, soap11Namespace}, envelopeVersions(f))
+		})
+	}
+}
+
+func TestSOAPAutoKeepsSOAP12Faults(t *testing.T) {
+	f := newFakeDevice(t)
+	dev := f.device(t, DeviceParams{SOAPVersion: SOAPAuto})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.Contains(t, readAll(t, resp), "ActionNotSupported")
+	assert.Equal(t, []string{soap12Namespace}, envelopeVersions(f))
+}
+
+func TestSOAPVersions(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("GetDeviceInformation", legacySOAP(unsupportedMediaType, deviceInformation))
+
+	dev := f.device(t, DeviceParams{SOAPVersion: SOAP11})
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+
+	// SOAP 1.2 is never downgraded unless asked
+	dev = f.device(t, DeviceParams{})
+	resp, err = dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnsupportedMediaType, resp.StatusCode)
+	readAll(t, resp)
+
+	assert.Equal(t, []string{soap11Namespace, soap12Namespace}, envelopeVersions(f))
+	assert.Equal(t, "SOAP 1.1", SOAP11.String())
+}
@@ -0,0 +1,135 @@
+package onvif
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/beevik/etree"
+)
+
+// directory is shared by all the copies of a Device. It holds the endpoints
+// and the services of the device, replaced as a whole by Refresh, the
+// information loaded by LoadInfo, and the capabilities loaded on demand.
+type directory struct {
+	sync.RWMutex
+	endpoints map[string]string
+	services  map[string]ServiceInfo
+	// advertised holds the capabilities included in the GetServices reply, by namespace
+	advertised map[string]*etree.Element
+	info       DeviceInfo
+	caps       Capabilities
+
+	// capsLoading serializes the loadings of the capabilities
+	capsLoading sync.Mutex
+}
+
+func newDirectory() *directory {
+	return &directory{
+		endpoints:  make(map[string]string),
+		services:   make(map[string]ServiceInfo),
+		advertised: make(map[string]*etree.Element),
+	}
+}
+
+// DeviceState is what a Device learnt from the device, to be saved (e.g. as
+// JSON) and given back to RestoreDevice so that the discovery can be skipped.
+type DeviceState struct {
+	Xaddr     string                 `json:"xaddr"`
+	Endpoints map[string]string      `json:"endpoints"`
+	Services  map[string]ServiceInfo `json:"services,omitempty"`
+	Info      DeviceInfo             `json:"info"`
+	// Capabilities are loaded again on demand when empty
+	Capabilities Capabilities  `json:"capabilities,omitempty"`
+	ClockOffset  time.Duration `json:"clockOffset,omitempty"`
+}
+
+// RestoreDevice constructs a ONVIF Device entity from a saved state, without
+// network I/O. params.Xaddr defaults to the address of the state.
+func RestoreDevice(params DeviceParams, state DeviceState) (*Device, error) {
+	if params.Xaddr == "" {
+		params.Xaddr = state.Xaddr
+	}
+	dev, err := NewDeviceOffline(params)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, xaddr := range state.Endpoints {
+		dev.addEndpoint(key, xaddr)
+	}
+	for ns, service := range state.Services {
+		dev.dir.services[ns] = service
+	}
+	dev.dir.info = state.Info
+	if len(state.Capabilities) > 0 {
+		dev.dir.caps = maps.Clone(state.Capabilities)
+	}
+	dev.clock.offset.Store(int64(state.ClockOffset))
+	return dev, nil
+}
+
+// State returns what the device learnt from the device, for RestoreDevice
+func (dev *Device) State() DeviceState {
+	return DeviceState{
+		Xaddr:        dev.xaddr.String(),
+		Endpoints:    dev.GetServices(),
+		Services:     dev.SupportedServices(),
+		Info:         dev.GetDeviceInfo(),
+		Capabilities: maps.Clone(dev.dir.capabilities()),
+		ClockOffset:  dev.ClockOffset(),
+	}
+}
+
+// Connect measures the clock offset of the device, discovers its services and
+// loads its information. It is done by NewDevice, and needed after NewDeviceOffline.
+func (dev *Device) Connect(ctx context.Context) error {
+	// A drifted clock makes the device reject the WS-Security tokens, measure
+	// it first. Devices unable to tell their time are used without correction.
+	dev.SyncClock(ctx)
+
+	if err := dev.Refresh(ctx); err != nil {
+		return err
+	}
+
+	// The information is not needed to use the device, e.g. when the
+	// credentials only grant the access to some services
+	dev.LoadInfo(ctx)
+	return nil
+}
+
+// Refresh discovers the services of the device again. The calls made meanwhile
+// use the previous endpoints, which are kept when the discovery fails.
+func (dev *Device) Refresh(ctx context.Context) error {
+	scratch := *dev
+	scratch.dir = newDirectory()
+	scratch.addEndpoint("Device", dev.xaddr.String())
+
+	if err := scratch.discoverServices(ctx); err != nil {
+		return err
+	}
+
+	dev.dir.Lock()
+	dev.dir.endpoints = scratch.dir.endpoints
+	dev.dir.services = scratch.dir.services
+	dev.dir.advertised = scratch.dir.advertised
+	// The capabilities of the services are loaded again on demand
+	dev.dir.caps = nil
+	dev.dir.Unlock()
+	return nil
+}
+
+// advertisedCapabilities returns the capabilities of the service ns included
+// in the GetServices reply, nil if none
+func (d *directory) advertisedCapabilities(ns string) *etree.Element {
+	d.RLock()
+	defer d.RUnlock()
+	return d.advertised[ns]
+}
+
+func (d *directory) capabilities() Capabilities {
+	d.RLock()
+	defer d.RUnlock()
+	return d.caps
+}
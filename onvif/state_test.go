@@ -0,0 +1,80 @@
+package onvif
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDeviceOffline(t *testing.T) {
+	f := newFakeDevice(t)
+	dev, err := NewDeviceOffline(DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	assert.Empty(t, f.received(), "no network I/O")
+	assert.Equal(t, map[string]string{"device": f.URL + "/onvif/device_service"}, dev.GetServices())
+
+	_, err = NewDeviceOffline(DeviceParams{Xaddr: "http://"})
+	assert.Error(t, err)
+}
+
+func TestConnect(t *testing.T) {
+	f := newFakeDevice(t)
+	driftedClock(f, time.Minute)
+	f.reply("GetServices", getServices)
+	f.reply("GetDeviceInformation", deviceInformation)
+
+	dev, err := NewDeviceOffline(DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	require.NoError(t, dev.Connect(context.Background()))
+
+	assert.Equal(t, []string{"GetSystemDateAndTime", "GetServices", "GetDeviceInformation", "GetEndpointReference"}, f.operations())
+	assert.InDelta(t, float64(time.Minute), float64(dev.ClockOffset()), float64(2*time.Second))
+	assert.Equal(t, f.URL+"/onvif/media2_service", dev.GetEndpoint("media2"))
+	assert.Equal(t, "ACME", dev.GetDeviceInfo().Manufacturer)
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	f := newFakeDevice(t)
+	driftedClock(f, time.Minute)
+	f.reply("GetServices", getServices)
+	f.reply("GetDeviceInformation", deviceInformation)
+
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+
+	saved, err := json.Marshal(dev.State())
+	require.NoError(t, err)
+	var state DeviceState
+	require.NoError(t, json.Unmarshal(saved, &state))
+
+	requests := len(f.received())
+	restored, err := RestoreDevice(DeviceParams{HttpClient: f.Client()}, state)
+	require.NoError(t, err)
+	assert.Len(t, f.received(), requests, "no network I/O")
+
+	assert.Equal(t, dev.GetServices(), restored.GetServices())
+	assert.Equal(t, dev.SupportedServices(), restored.SupportedServices())
+	assert.Equal(t, dev.GetDeviceInfo(), restored.GetDeviceInfo())
+	assert.Equal(t, dev.ClockOffset(), restored.ClockOffset())
+	assert.Equal(t, dev.Identity(), restored.Identity())
+	version, ok := restored.ServiceVersion(MediaNamespace)
+	assert.True(t, ok)
+	assert.Equal(t, Version{Major: 2, Minor: 60}, version)
+}
+
+func TestRefreshKeepsEndpointsOnFailure(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetServices", getServices)
+
+	dev, err := NewDeviceContext(context.Background(), DeviceParams{Xaddr: f.URL, HttpClient: f.Client()})
+	require.NoError(t, err)
+	endpoints := dev.GetServices()
+
+	f.handle("GetServices", nil)
+	assert.Error(t, dev.Refresh(context.Background()))
+	assert.Equal(t, endpoints, dev.GetServices())
+}
@@ -0,0 +1,102 @@
+package onvif
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrInsecureTransport is returned when DeviceParams.RequireTLS forbids to send
+// the credentials to an endpoint that is not HTTPS.
+var ErrInsecureTransport = errors.New("refusing to send credentials over plain HTTP")
+
+// ErrTLSWithTransport is returned when DeviceParams.TLS is set along with a
+// custom DeviceParams.Transport, which carries its own TLS configuration.
+var ErrTLSWithTransport = errors.New("TLS parameters cannot be applied to a custom Transport")
+
+// TLSConfig configures how the HTTPS endpoints of a device are authenticated
+type TLSConfig struct {
+	// RootCAs verifies the certificate of the device. The system pool is used when nil.
+	RootCAs *x509.CertPool
+	// Certificates are presented to the devices requiring a client certificate
+	Certificates []tls.Certificate
+	// PinnedSHA256 lists the accepted SHA-256 fingerprints of the certificate of
+	// the device, as hex strings (colons allowed). When set, the certificate must
+	// match one of them and the chain is not verified, so that self-signed
+	// certificates are accepted.
+	PinnedSHA256 []string
+	// ServerName overrides the name verified in the certificate of the device
+	ServerName string
+}
+
+func (c *TLSConfig) tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{
+		RootCAs:      c.RootCAs,
+		Certificates: c.Certificates,
+		ServerName:   c.ServerName,
+	}
+
+	if len(c.PinnedSHA256) == 0 {
+		return cfg, nil
+	}
+
+	pins := make(map[string]bool, len(c.PinnedSHA256))
+	for _, pin := range c.PinnedSHA256 {
+		fingerprint, err := hex.DecodeString(strings.ReplaceAll(strings.TrimSpace(pin), ":", ""))
+		if err != nil || len(fingerprint) != sha256.Size {
+			return nil, errors.New("invalid SHA-256 fingerprint " + pin)
+		}
+		pins[string(fingerprint)] = true
+	}
+
+	// The pin replaces the verification of the chain, the leaf is checked instead
+	cfg.InsecureSkipVerify = true
+	cfg.VerifyPeerCertificate = func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("no certificate presented by the device")
+		}
+		fingerprint := sha256.Sum256(rawCerts[0])
+		if !pins[string(fingerprint[:])] {
+			return errors.New("certificate of the device does not match the pinned fingerprints")
+		}
+		return nil
+	}
+	return cfg, nil
+}
+
+// httpClient returns the client to use with the device, configured with the TLS parameters
+func (params DeviceParams) httpClient() (*http.Client, error) {
+	if params.TLS == nil {
+		if params.HttpClient == nil {
+			return new(http.Client), nil
+		}
+		return params.HttpClient, nil
+	}
+
+	cfg, err := params.TLS.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client := new(http.Client)
+	if params.HttpClient != nil {
+		*client = *params.HttpClient
+	}
+
+	var transport *http.Transport
+	switch rt := client.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = rt.Clone()
+	default:
+		return nil, errors.New("TLS parameters cannot be applied to a custom http.RoundTripper")
+	}
+	transport.TLSClientConfig = cfg
+	client.Transport = transport
+	return client, nil
+}
@@ -0,0 +1,134 @@
+package onvif
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/networking"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fingerprint(f *fakeDevice) string {
+	sum := sha256.Sum256(f.Certificate().Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestTLSPinnedCertificate(t *testing.T) {
+	f := newFakeTLSDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+
+	// Colons and upper case are accepted
+	pin := strings.ToUpper(fingerprint(f))
+	pin = pin[:2] + ":" + pin[2:]
+	dev := f.device(t, DeviceParams{TLS: &TLSConfig{PinnedSHA256: []string{pin}}})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+}
+
+func TestTLSWrongPin(t *testing.T) {
+	f := newFakeTLSDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{TLS: &TLSConfig{PinnedSHA256: []string{strings.Repeat("ab", sha256.Size)}}})
+
+	_, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pinned fingerprints")
+	assert.Empty(t, f.received())
+}
+
+func TestTLSRootCAs(t *testing.T) {
+	f := newFakeTLSDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+
+	pool := x509.NewCertPool()
+	pool.AddCert(f.Certificate())
+	dev := f.device(t, DeviceParams{TLS: &TLSConfig{RootCAs: pool}})
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	readAll(t, resp)
+
+	// Unknown authority
+	dev = f.device(t, DeviceParams{TLS: &TLSConfig{RootCAs: x509.NewCertPool()}})
+	_, err = dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	assert.Error(t, err)
+}
+
+func TestTLSInvalidParameters(t *testing.T) {
+	_, err := NewDeviceOffline(DeviceParams{Xaddr: "https://camera", TLS: &TLSConfig{PinnedSHA256: []string{"00:11"}}})
+	assert.Error(t, err)
+
+	client := &http.Client{Transport: roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("unused")
+	})}
+	_, err = NewDeviceOffline(DeviceParams{Xaddr: "https://camera", HttpClient: client, TLS: &TLSConfig{}})
+	assert.Error(t, err)
+}
+
+func TestRequireTLS(t *testing.T) {
+	f := newFakeDevice(t)
+	f.reply("GetDeviceInformation", deviceInformation)
+	dev := f.device(t, DeviceParams{Username: "admin", Password: "secret", RequireTLS: true})
+
+	_, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	assert.ErrorIs(t, err, ErrInsecureTransport)
+	assert.Empty(t, f.received(), "the credentials are not sent")
+
+	// Without credentials, plain HTTP is fine
+	dev = f.device(t, DeviceParams{RequireTLS: true})
+	resp, err := dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	readAll(t, resp)
+
+	tlsDevice := newFakeTLSDevice(t)
+	tlsDevice.reply("GetDeviceInformation", deviceInformation)
+	dev = tlsDevice.device(t, DeviceParams{Username: "admin", Password: "secret", RequireTLS: true,
+		TLS: &TLSConfig{PinnedSHA256: []string{fingerprint(tlsDevice)}}})
+	resp, err = dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	readAll(t, resp)
+}
+
+func TestHTTPSXaddr(t *testing.T) {
+	dev, err := NewDeviceOffline(DeviceParams{Xaddr: "https://192.168.0.10:8443"})
+	require.NoError(t, err)
+	assert.Equal(t, "https://192.168.0.10:8443/onvif/device_service", dev.GetEndpoint("device"))
+
+	// The advertised host is replaced, the port too unless another scheme is advertised
+	dev.addEndpoint("media", "https://10.0.0.1/onvif/media")
+	assert.Equal(t, "https://192.168.0.10:8443/onvif/media", dev.GetEndpoint("media"))
+	dev.addEndpoint("events", "http://10.0.0.1:8080/onvif/events")
+	assert.Equal(t, "http://192.168.0.10:8080/onvif/events", dev.GetEndpoint("events"))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestTLSWithCustomTransport(t *testing.T) {
+	transport := networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+		return nil, errors.New("not sent")
+	})
+	_, err := NewDeviceOffline(DeviceParams{Xaddr: "https://camera", Transport: transport, TLS: &TLSConfig{}})
+	assert.ErrorIs(t, err, ErrTLSWithTransport)
+
+	// RequireTLS is checked before the custom transport
+	dev, err := NewDeviceOffline(DeviceParams{Xaddr: "camera", Transport: transport, Username: "admin", Password: "secret", RequireTLS: true})
+	require.NoError(t, err)
+	_, err = dev.CallMethodContext(context.Background(), device.GetDeviceInformation{})
+	assert.ErrorIs(t, err, ErrInsecureTransport)
+}
@@ -0,0 +1,140 @@
+package onvif
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/networking"
+)
+
+// TraceEvent describes a SOAP exchange with a device. The passwords and the
+// nonces of the envelopes are redacted.
+type TraceEvent struct {
+	Time      time.Time
+	Endpoint  string
+	Operation string
+	Request   string
+	// RequestContentType is empty for the default SOAP 1.2 Content-Type
+	RequestContentType string
+	// Response is empty when the request failed before any reply, and for
+	// the MTOM replies, whose attachments are streamed to the caller
+	Response    string
+	ContentType string
+	StatusCode  int
+	Duration    time.Duration
+	Err         error
+}
+
+// secretElements matches the content of the Password and Nonce elements,
+// whatever their namespace and attributes, text or CDATA sections: the
+// UsernameToken and the users of CreateUsers/SetUser
+var secretElements = regexp.MustCompile(`(<(?:[\w.-]+:)?(?:Password|Nonce)(?:\s+[\w.:-]+\s*=\s*(?:"[^"]*"|'[^']*'))*\s*>)(?:[^<]+|<!\[CDATA\[(?s:.*?)\]\]>)*(<)`)
+
+// RedactEnvelope hides the passwords and the nonces held by envelope
+func RedactEnvelope(envelope string) string {
+	return secretElements.ReplaceAllString(envelope, "${1}REDACTED${2}")
+}
+
+// tracing calls trace after each round trip. The reply is read completely to
+// be traced, then replayed to the caller, unless it is an MTOM message.
+func tracing(trace func(TraceEvent)) networking.Middleware {
+	return func(next networking.Transport) networking.Transport {
+		return networking.TransportFunc(func(ctx context.Context, req *networking.Request) (*http.Response, error) {
+			event := TraceEvent{
+				Time:      time.Now(),
+				Endpoint:  req.Endpoint,
+				Operation: req.Operation,
+				Request:   RedactEnvelope(req.Envelope),
+			}
+			event.RequestContentType = req.Header.Get("Content-Type")
+
+			resp, err := next.RoundTrip(ctx, req)
+			if err == nil && gosoap.IsMTOM(resp.Header.Get("Content-Type")) {
+				event.ContentType = resp.Header.Get("Content-Type")
+				event.StatusCode = resp.StatusCode
+			} else if err == nil {
+				var data []byte
+				data, err = io.ReadAll(resp.Body)
+				resp.Body.Close()
+				resp.Body = io.NopCloser(bytes.NewReader(data))
+				event.Response = RedactEnvelope(string(data))
+				event.ContentType = resp.Header.Get("Content-Type")
+				event.StatusCode = resp.StatusCode
+				if err != nil {
+					resp = nil
+				}
+			}
+			event.Duration = time.Since(event.Time)
+			event.Err = err
+
+			trace(event)
+			return resp, err
+		})
+	}
+}
+
+// harEntry is the subset of the entries of the HTTP Archive format used by JSONLTrace
+type harEntry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	Time            float64   `json:"time"`
+	Request         struct {
+		Method   string `json:"method"`
+		URL      string `json:"url"`
+		PostData struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+		} `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status  int `json:"status"`
+		Content struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+		} `json:"content"`
+	} `json:"response"`
+	Comment string `json:"comment"`
+	Error   string `json:"_error,omitempty"`
+}
+
+// JSONLTrace returns a trace hook writing each event to w as a line of JSON,
+// formatted as an entry of the HTTP Archive (HAR) format. It may be shared by
+// several devices.
+func JSONLTrace(w io.Writer) func(TraceEvent) {
+	var mu sync.Mutex
+	return func(event TraceEvent) {
+		var entry harEntry
+		entry.StartedDateTime = event.Time
+		entry.Time = float64(event.Duration) / float64(time.Millisecond)
+		entry.Request.Method = http.MethodPost
+		entry.Request.URL = event.Endpoint
+		entry.Request.PostData.MimeType = event.RequestContentType
+		if entry.Request.PostData.MimeType == "" {
+			entry.Request.PostData.MimeType = "application/soap+xml; charset=utf-8"
+		}
+		entry.Request.PostData.Text = event.Request
+		entry.Response.Status = event.StatusCode
+		entry.Response.Content.MimeType = event.ContentType
+		entry.Response.Content.Text = event.Response
+		entry.Comment = event.Operation
+		if event.Err != nil {
+			entry.Error = event.Err.Error()
+		}
+
+		var line bytes.Buffer
+		encoder := json.NewEncoder(&line)
+		encoder.SetEscapeHTML(false)
+		if err := encoder.Encode(entry); err != nil {
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write(line.Bytes())
+	}
+}
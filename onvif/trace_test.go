@@ -0,0 +1,73 @@
+package onvif
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	xsdonvif "github.com/BalkarSandhu/go-onvif/xsd/onvif"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedactEnvelope(t *testing.T) {
+	for envelope, redacted := range map[string]string{
+		`<wsse:Password>secret</wsse:Password>`: `<wsse:Password>REDACTED</wsse:Password>`,
+		`<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest">c2VjcmV0</wsse:Password>`: `<wsse:Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest">REDACTED</wsse:Password>`,
+		`<wsse:Nonce EncodingType='a>b' >bm9uY2U=</wsse:Nonce>`:          `<wsse:Nonce EncodingType='a>b' >REDACTED</wsse:Nonce>`,
+		`<tt:Password><![CDATA[se<cr>et]]></tt:Password>`:                `<tt:Password>REDACTED</tt:Password>`,
+		`<Password>a<![CDATA[b]]>c</Password><Username>admin</Username>`: `<Password>REDACTED</Password><Username>admin</Username>`,
+		`<tt:PasswordHint>kept</tt:PasswordHint><tt:Password/>`:          `<tt:PasswordHint>kept</tt:PasswordHint><tt:Password/>`,
+		"<tds:User>\n<tt:Password>\nsecret\n</tt:Password>\n</tds:User>": "<tds:User>\n<tt:Password>REDACTED</tt:Password>\n</tds:User>",
+	} {
+		assert.Equal(t, redacted, RedactEnvelope(envelope))
+	}
+}
+
+func TestTrace(t *testing.T) {
+	f := newFakeDevice(t)
+	f.handle("CreateUsers", func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, `<tds:CreateUsersResponse/>`)
+	})
+	var events []TraceEvent
+	dev := f.device(t, DeviceParams{
+		Username: "admin",
+		Password: "secret",
+		Trace:    func(event TraceEvent) { events = append(events, event) },
+	})
+
+	resp, err := dev.CallMethodContext(context.Background(), device.CreateUsers{User: xsdonvif.User{
+		Username: "operator", Password: "hunter2", UserLevel: "Operator",
+	}})
+	require.NoError(t, err)
+	assert.Contains(t, readAll(t, resp), "CreateUsersResponse", "the traced reply is replayed")
+
+	require.Len(t, events, 1)
+	event := events[0]
+	assert.Equal(t, "CreateUsers", event.Operation)
+	assert.Equal(t, dev.GetEndpoint("device"), event.Endpoint)
+	assert.Equal(t, http.StatusOK, event.StatusCode)
+	assert.Contains(t, event.Response, "CreateUsersResponse")
+	assert.NoError(t, event.Err)
+
+	sent := f.received()[0]
+	for _, secret := range []string{"hunter2", sent.text("//Security/UsernameToken/Password"), sent.text("//Security/UsernameToken/Nonce")} {
+		require.NotEmpty(t, secret)
+		assert.NotContains(t, event.Request, secret)
+	}
+	assert.Contains(t, event.Request, "operator")
+
+	var line bytes.Buffer
+	JSONLTrace(&line)(event)
+	var entry harEntry
+	require.NoError(t, json.Unmarshal(line.Bytes(), &entry))
+	assert.True(t, strings.HasSuffix(line.String(), "\n"))
+	assert.Equal(t, event.Request, entry.Request.PostData.Text)
+	assert.Equal(t, "application/soap+xml; charset=utf-8", entry.Request.PostData.MimeType)
+	assert.Equal(t, "CreateUsers", entry.Comment)
+}
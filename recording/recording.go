@@ -0,0 +1,4 @@
+// Package recording declares the types of the ONVIF Recording service, generated from its WSDL.
+package recording
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../docs/wsdl/recording.wsdl -types -o types_auto.go recording
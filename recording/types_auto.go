@@ -0,0 +1,335 @@
+// Code generated by sdk/codegen from recording.wsdl : DO NOT EDIT.
+
+package recording
+
+import (
+	"github.com/BalkarSandhu/go-onvif/xsd"
+	"github.com/BalkarSandhu/go-onvif/xsd/onvif"
+)
+
+// GetServiceCapabilities is the request of the GetServiceCapabilities operation. Returns the
+// capabilities of the recording service. The result is returned in a typed answer.
+type GetServiceCapabilities struct {
+	XMLName string `xml:"trc:GetServiceCapabilities"`
+}
+
+// GetServiceCapabilitiesResponse is the reply to the GetServiceCapabilities operation.
+type GetServiceCapabilitiesResponse struct {
+	Capabilities Capabilities `xml:"Capabilities"`
+}
+
+// CreateRecording is the request of the CreateRecording operation. CreateRecording shall create a
+// new recording. The new recording shall be created with a track for each supported TrackType see
+// Recording Control Spec. This method is optional. It shall be available if the
+// Recording/DynamicRecordings capability is TRUE. When successfully completed, CreateRecording
+// shall have created three tracks with the following configurations: All TrackConfigurations shall
+// have the MaximumRetentionTime set to 0 (unlimited), and the Description set to the empty string.
+type CreateRecording struct {
+	XMLName                string                       `xml:"trc:CreateRecording"`
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"trc:RecordingConfiguration"`
+}
+
+// CreateRecordingResponse is the reply to the CreateRecording operation.
+type CreateRecordingResponse struct {
+	RecordingToken onvif.RecordingReference `xml:"RecordingToken"`
+}
+
+// DeleteRecording is the request of the DeleteRecording operation. DeleteRecording shall delete a
+// recording object. Whenever a recording is deleted, the device shall delete all the tracks that
+// are part of the recording, and it shall delete all the Recording Jobs that record into the
+// recording. For each deleted recording job, the device shall also delete all the receiver objects
+// associated with the recording job that are automatically created using the AutoCreateReceiver
+// field of the recording job configuration structure and are not used in any other recording job.
+// This method is optional. It shall be available if the Recording/DynamicRecordings capability is
+// TRUE.
+type DeleteRecording struct {
+	XMLName        string                   `xml:"trc:DeleteRecording"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+}
+
+// DeleteRecordingResponse is the reply to the DeleteRecording operation.
+type DeleteRecordingResponse struct {
+}
+
+// GetRecordings is the request of the GetRecordings operation. GetRecordings shall return a
+// description of all the recordings in the device. This description shall include a list of all the
+// tracks for each recording.
+type GetRecordings struct {
+	XMLName string `xml:"trc:GetRecordings"`
+}
+
+// GetRecordingsResponse is the reply to the GetRecordings operation.
+type GetRecordingsResponse struct {
+	RecordingItem []onvif.GetRecordingsResponseItem `xml:"RecordingItem"`
+}
+
+// SetRecordingConfiguration is the request of the SetRecordingConfiguration operation.
+// SetRecordingConfiguration shall change the configuration of a recording.
+type SetRecordingConfiguration struct {
+	XMLName                string                       `xml:"trc:SetRecordingConfiguration"`
+	RecordingToken         onvif.RecordingReference     `xml:"trc:RecordingToken"`
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"trc:RecordingConfiguration"`
+}
+
+// SetRecordingConfigurationResponse is the reply to the SetRecordingConfiguration operation.
+type SetRecordingConfigurationResponse struct {
+}
+
+// GetRecordingConfiguration is the request of the GetRecordingConfiguration operation.
+// GetRecordingConfiguration shall retrieve the recording configuration for a recording.
+type GetRecordingConfiguration struct {
+	XMLName        string                   `xml:"trc:GetRecordingConfiguration"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+}
+
+// GetRecordingConfigurationResponse is the reply to the GetRecordingConfiguration operation.
+type GetRecordingConfigurationResponse struct {
+	RecordingConfiguration onvif.RecordingConfiguration `xml:"RecordingConfiguration"`
+}
+
+// GetRecordingOptions is the request of the GetRecordingOptions operation. GetRecordingOptions
+// returns information for a recording identified by the RecordingToken. The information includes
+// the number of additonal tracks as well as recording jobs that can be configured.
+type GetRecordingOptions struct {
+	XMLName        string                   `xml:"trc:GetRecordingOptions"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+}
+
+// GetRecordingOptionsResponse is the reply to the GetRecordingOptions operation.
+type GetRecordingOptionsResponse struct {
+	Options RecordingOptions `xml:"Options"`
+}
+
+// CreateTrack is the request of the CreateTrack operation. This method shall create a new track
+// within a recording. This method is optional. It shall be available if the Recording/DynamicTracks
+// capability is TRUE. A TrackToken in itself does not uniquely identify a specific track. Tracks
+// within different recordings may have the same TrackToken.
+type CreateTrack struct {
+	XMLName            string                   `xml:"trc:CreateTrack"`
+	RecordingToken     onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackConfiguration onvif.TrackConfiguration `xml:"trc:TrackConfiguration"`
+}
+
+// CreateTrackResponse is the reply to the CreateTrack operation.
+type CreateTrackResponse struct {
+	TrackToken onvif.TrackReference `xml:"TrackToken"`
+}
+
+// DeleteTrack is the request of the DeleteTrack operation. DeleteTrack shall remove a track from a
+// recording. All the data in the track shall be deleted. This method is optional. It shall be
+// available if the Recording/DynamicTracks capability is TRUE.
+type DeleteTrack struct {
+	XMLName        string                   `xml:"trc:DeleteTrack"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackToken     onvif.TrackReference     `xml:"trc:TrackToken"`
+}
+
+// DeleteTrackResponse is the reply to the DeleteTrack operation.
+type DeleteTrackResponse struct {
+}
+
+// GetTrackConfiguration is the request of the GetTrackConfiguration operation.
+// GetTrackConfiguration shall retrieve the configuration for a specific track.
+type GetTrackConfiguration struct {
+	XMLName        string                   `xml:"trc:GetTrackConfiguration"`
+	RecordingToken onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackToken     onvif.TrackReference     `xml:"trc:TrackToken"`
+}
+
+// GetTrackConfigurationResponse is the reply to the GetTrackConfiguration operation.
+type GetTrackConfigurationResponse struct {
+	TrackConfiguration onvif.TrackConfiguration `xml:"TrackConfiguration"`
+}
+
+// SetTrackConfiguration is the request of the SetTrackConfiguration operation.
+// SetTrackConfiguration shall change the configuration of a track.
+type SetTrackConfiguration struct {
+	XMLName            string                   `xml:"trc:SetTrackConfiguration"`
+	RecordingToken     onvif.RecordingReference `xml:"trc:RecordingToken"`
+	TrackToken         onvif.TrackReference     `xml:"trc:TrackToken"`
+	TrackConfiguration onvif.TrackConfiguration `xml:"trc:TrackConfiguration"`
+}
+
+// SetTrackConfigurationResponse is the reply to the SetTrackConfiguration operation.
+type SetTrackConfigurationResponse struct {
+}
+
+// CreateRecordingJob is the request of the CreateRecordingJob operation. CreateRecordingJob shall
+// create a new recording job. The JobConfiguration returned from CreateRecordingJob shall be
+// identical to the JobConfiguration passed into CreateRecordingJob, except for the ReceiverToken
+// and the AutoCreateReceiver. In the returned structure, the ReceiverToken shall be present and
+// valid and the AutoCreateReceiver field shall be omitted.
+type CreateRecordingJob struct {
+	XMLName          string                          `xml:"trc:CreateRecordingJob"`
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"trc:JobConfiguration"`
+}
+
+// CreateRecordingJobResponse is the reply to the CreateRecordingJob operation.
+type CreateRecordingJobResponse struct {
+	JobToken         onvif.RecordingJobReference     `xml:"JobToken"`
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"JobConfiguration"`
+}
+
+// DeleteRecordingJob is the request of the DeleteRecordingJob operation. DeleteRecordingJob removes
+// a recording job. It shall also implicitly delete all the receiver objects associated with the
+// recording job that are automatically created using the AutoCreateReceiver field of the recording
+// job configuration structure and are not used in any other recording job.
+type DeleteRecordingJob struct {
+	XMLName  string                      `xml:"trc:DeleteRecordingJob"`
+	JobToken onvif.RecordingJobReference `xml:"trc:JobToken"`
+}
+
+// DeleteRecordingJobResponse is the reply to the DeleteRecordingJob operation.
+type DeleteRecordingJobResponse struct {
+}
+
+// GetRecordingJobs is the request of the GetRecordingJobs operation. GetRecordingJobs shall return
+// a list of all the recording jobs in the device.
+type GetRecordingJobs struct {
+	XMLName string `xml:"trc:GetRecordingJobs"`
+}
+
+// GetRecordingJobsResponse is the reply to the GetRecordingJobs operation.
+type GetRecordingJobsResponse struct {
+	JobItem []onvif.GetRecordingJobsResponseItem `xml:"JobItem"`
+}
+
+// SetRecordingJobConfiguration is the request of the SetRecordingJobConfiguration operation.
+// SetRecordingJobConfiguration shall change the configuration for a recording job.
+// SetRecordingJobConfiguration shall implicitly delete any receiver objects that were created
+// automatically if they are no longer used as a result of changing the recording job configuration.
+type SetRecordingJobConfiguration struct {
+	XMLName          string                          `xml:"trc:SetRecordingJobConfiguration"`
+	JobToken         onvif.RecordingJobReference     `xml:"trc:JobToken"`
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"trc:JobConfiguration"`
+}
+
+// SetRecordingJobConfigurationResponse is the reply to the SetRecordingJobConfiguration operation.
+type SetRecordingJobConfigurationResponse struct {
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"JobConfiguration"`
+}
+
+// GetRecordingJobConfiguration is the request of the GetRecordingJobConfiguration operation.
+// GetRecordingJobConfiguration shall return the current configuration for a recording job.
+type GetRecordingJobConfiguration struct {
+	XMLName  string                      `xml:"trc:GetRecordingJobConfiguration"`
+	JobToken onvif.RecordingJobReference `xml:"trc:JobToken"`
+}
+
+// GetRecordingJobConfigurationResponse is the reply to the GetRecordingJobConfiguration operation.
+type GetRecordingJobConfigurationResponse struct {
+	JobConfiguration onvif.RecordingJobConfiguration `xml:"JobConfiguration"`
+}
+
+// SetRecordingJobMode is the request of the SetRecordingJobMode operation. SetRecordingJobMode
+// shall change the mode of the recording job. Using this method shall be equivalent to retrieving
+// the recording job configuration, and writing it back with a different mode.
+type SetRecordingJobMode struct {
+	XMLName  string                      `xml:"trc:SetRecordingJobMode"`
+	JobToken onvif.RecordingJobReference `xml:"trc:JobToken"`
+	Mode     onvif.RecordingJobMode      `xml:"trc:Mode"`
+}
+
+// SetRecordingJobModeResponse is the reply to the SetRecordingJobMode operation.
+type SetRecordingJobModeResponse struct {
+}
+
+// GetRecordingJobState is the request of the GetRecordingJobState operation. GetRecordingJobState
+// returns the state of a recording job. It includes an aggregated state, and state for each track
+// of the recording job.
+type GetRecordingJobState struct {
+	XMLName  string                      `xml:"trc:GetRecordingJobState"`
+	JobToken onvif.RecordingJobReference `xml:"trc:JobToken"`
+}
+
+// GetRecordingJobStateResponse is the reply to the GetRecordingJobState operation.
+type GetRecordingJobStateResponse struct {
+	State onvif.RecordingJobStateInformation `xml:"State"`
+}
+
+// ExportRecordedData is the request of the ExportRecordedData operation. Exports the selected
+// recordings (from existing recorded data) to the given storage target based on the requested file
+// format.
+type ExportRecordedData struct {
+	XMLName            string                     `xml:"trc:ExportRecordedData"`
+	StartPoint         xsd.DateTime               `xml:"trc:StartPoint,omitempty"`
+	EndPoint           xsd.DateTime               `xml:"trc:EndPoint,omitempty"`
+	SearchScope        onvif.SearchScope          `xml:"trc:SearchScope"`
+	FileFormat         xsd.String                 `xml:"trc:FileFormat"`
+	StorageDestination onvif.StorageReferencePath `xml:"trc:StorageDestination"`
+}
+
+// ExportRecordedDataResponse is the reply to the ExportRecordedData operation.
+type ExportRecordedDataResponse struct {
+	OperationToken onvif.ReferenceToken                `xml:"OperationToken"`
+	FileNames      []xsd.String                        `xml:"FileNames"`
+	Extension      ExportRecordedDataResponseExtension `xml:"Extension"`
+}
+
+// ExportRecordedDataResponseExtension type.
+type ExportRecordedDataResponseExtension struct {
+	Any string `xml:",innerxml"`
+}
+
+// StopExportRecordedData is the request of the StopExportRecordedData operation. Stops the selected
+// ExportRecordedData operation.
+type StopExportRecordedData struct {
+	XMLName        string               `xml:"trc:StopExportRecordedData"`
+	OperationToken onvif.ReferenceToken `xml:"trc:OperationToken"`
+}
+
+// StopExportRecordedDataResponse is the reply to the StopExportRecordedData operation.
+type StopExportRecordedDataResponse struct {
+	Progress           xsd.Float                 `xml:"Progress"`
+	FileProgressStatus onvif.ArrayOfFileProgress `xml:"FileProgressStatus"`
+}
+
+// GetExportRecordedDataState is the request of the GetExportRecordedDataState operation. Retrieves
+// the status of selected ExportRecordedData operation.
+type GetExportRecordedDataState struct {
+	XMLName        string               `xml:"trc:GetExportRecordedDataState"`
+	OperationToken onvif.ReferenceToken `xml:"trc:OperationToken"`
+}
+
+// GetExportRecordedDataStateResponse is the reply to the GetExportRecordedDataState operation.
+type GetExportRecordedDataStateResponse struct {
+	Progress           xsd.Float                 `xml:"Progress"`
+	FileProgressStatus onvif.ArrayOfFileProgress `xml:"FileProgressStatus"`
+}
+
+// Capabilities type.
+type Capabilities struct {
+	DynamicRecordings          xsd.Boolean       `xml:"DynamicRecordings,attr"`
+	DynamicTracks              xsd.Boolean       `xml:"DynamicTracks,attr"`
+	Encoding                   EncodingTypes     `xml:"Encoding,attr"`
+	MaxRate                    xsd.Float         `xml:"MaxRate,attr"`
+	MaxTotalRate               xsd.Float         `xml:"MaxTotalRate,attr"`
+	MaxRecordings              xsd.Float         `xml:"MaxRecordings,attr"`
+	MaxRecordingJobs           xsd.Int           `xml:"MaxRecordingJobs,attr"`
+	Options                    xsd.Boolean       `xml:"Options,attr"`
+	MetadataRecording          xsd.Boolean       `xml:"MetadataRecording,attr"`
+	SupportedExportFileFormats xsd.AnySimpleType `xml:"SupportedExportFileFormats,attr"`
+}
+
+// EncodingTypes type.
+type EncodingTypes xsd.AnySimpleType
+
+// RecordingOptions type.
+type RecordingOptions struct {
+	Job   JobOptions   `xml:"Job"`
+	Track TrackOptions `xml:"Track"`
+}
+
+// JobOptions type.
+type JobOptions struct {
+	Spare             xsd.Int           `xml:"Spare,attr"`
+	CompatibleSources xsd.AnySimpleType `xml:"CompatibleSources,attr"`
+}
+
+// TrackOptions type.
+type TrackOptions struct {
+	SpareTotal    xsd.Int `xml:"SpareTotal,attr"`
+	SpareVideo    xsd.Int `xml:"SpareVideo,attr"`
+	SpareAudio    xsd.Int `xml:"SpareAudio,attr"`
+	SpareMetadata xsd.Int `xml:"SpareMetadata,attr"`
+}
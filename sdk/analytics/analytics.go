@@ -0,0 +1,3 @@
+package analytics
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../../docs/wsdl/analytics.wsdl analytics analytics
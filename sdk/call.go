@@ -0,0 +1,77 @@
+package sdk
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/BalkarSandhu/go-onvif/event"
+	"github.com/BalkarSandhu/go-onvif/onvif"
+	"github.com/juju/errors"
+)
+
+// Call sends request to the service of dev it belongs to, then decodes the
+// reply element named after Resp, e.g. GetProfilesResponse, into a Resp, a
+// reply without it failing with a NotFound error.
+// Any request struct marshaling to a SOAP operation may be sent, vendor
+// extensions included:
+//
+//	profiles, err := sdk.Call[media.GetProfiles, media.GetProfilesResponse](ctx, dev, media.GetProfiles{})
+//
+// The attachments of an MTOM reply are streamed, the parts held in memory
+// are bounded by dev.MaxResponseSize. A pointer to a request is sent as the
+// request it points to.
+func Call[Req, Resp any](ctx context.Context, dev *onvif.Device, request Req) (Resp, error) {
+	return call[Resp](ctx, request, dev.MaxResponseSize(), func(request interface{}) (*http.Response, error) {
+		return dev.CallMethodContext(onvif.WithStreamedAttachments(ctx), request)
+	})
+}
+
+// CallEndpoint sends request to the endpoint reference ref, e.g. the
+// SubscriptionReference of an event subscription, then decodes the reply as Call does
+func CallEndpoint[Req, Resp any](ctx context.Context, dev *onvif.Device, ref event.EndpointReferenceType, request Req) (Resp, error) {
+	return call[Resp](ctx, request, dev.MaxResponseSize(), func(request interface{}) (*http.Response, error) {
+		return dev.CallEndpointContext(onvif.WithStreamedAttachments(ctx), ref, request)
+	})
+}
+
+func call[Resp any](ctx context.Context, request interface{}, limit int64, send func(request interface{}) (*http.Response, error)) (Resp, error) {
+	var response Resp
+	// The request and the reply are named after their types
+	req := reflect.ValueOf(request)
+	for req.Kind() == reflect.Ptr && !req.IsNil() {
+		req = req.Elem()
+	}
+	name := reflect.TypeOf(&response).Elem().Name()
+	if req.Kind() != reflect.Struct || req.Type().Name() == "" {
+		return response, errors.NotValidf("request of type %T", request)
+	}
+	if name == "" {
+		return response, errors.NotValidf("reply of type %T", response)
+	}
+
+	httpReply, err := send(req.Interface())
+	if err != nil {
+		return response, errors.Annotate(err, "call")
+	}
+
+	found, other := false, ""
+	err = readReply(ctx, httpReply, req.Type().Name(), &response, func(local string) interface{} {
+		if local == name {
+			found = true
+			return &response
+		}
+		if other == "" {
+			other = local
+		}
+		return nil
+	}, limit)
+	switch {
+	case err != nil || found:
+	case other != "":
+		err = errors.New("reply holds a " + other + " instead of a " + name)
+	default:
+		err = errors.NotFoundf("%s in the reply", name)
+	}
+	return response, errors.Annotate(err, "reply")
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2022 Jean-Francois SMIGIELSKI
+// Distributed under the MIT License
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// checkReplies checks that the elements of the replies are matched by the
+// generated types and the ones they use, whatever the prefixes used by the
+// device: the types naming their elements with a prefix must be decoded by
+// an UnmarshalXML renaming them, and the ones naming them without prefix
+// must not, as onvif.DecodeQualified does for everything it decodes.
+func (g *typeGenerator) checkReplies(src []byte) error {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return err
+	}
+	generated := &goPackage{
+		types:    make(map[string]bool),
+		structs:  make(map[string]*ast.StructType),
+		decoders: make(map[string]decoding),
+	}
+	generated.addFile(f)
+
+	// The packages by qualifier, the types of the package written to being
+	// either generated or mapped to it
+	qualified := map[string]*goPackage{"": generated}
+	for _, p := range g.packages {
+		if p.path != "" {
+			qualified[p.name] = p
+			continue
+		}
+		for name, st := range p.structs {
+			generated.structs[name] = st
+		}
+		for name, how := range p.decoders {
+			generated.decoders[name] = how
+		}
+	}
+
+	c := &replyChecker{packages: qualified, visited: make(map[string]bool), unmatched: make(map[string]bool)}
+	for _, op := range g.svc.operations {
+		if op.outputNamespace == g.svc.namespace {
+			c.walk("", exported(op.output), false)
+		}
+	}
+	if len(c.unmatched) == 0 {
+		return nil
+	}
+	var unmatched []string
+	for name := range c.unmatched {
+		unmatched = append(unmatched, name)
+	}
+	sort.Strings(unmatched)
+	return fmt.Errorf("types whose elements are not matched in the replies: %s", strings.Join(unmatched, ", "))
+}
+
+// replyChecker walks the types decoded from the replies
+type replyChecker struct {
+	packages  map[string]*goPackage
+	visited   map[string]bool
+	unmatched map[string]bool
+}
+
+// walk checks the type name of the package qualifier, decoded with its
+// elements renamed or not
+func (c *replyChecker) walk(qualifier, name string, renamed bool) {
+	p := c.packages[qualifier]
+	if p == nil || p.structs[name] == nil {
+		return
+	}
+	key := fmt.Sprintf("%s.%s %v", qualifier, name, renamed)
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+
+	if how, ok := p.decoders[name]; ok {
+		if how == decodeCustom {
+			return
+		}
+		renamed = how == decodeQualified
+	}
+	for _, f := range p.structs[name].Fields.List {
+		if len(f.Names) > 0 && elementPrefixed(f) != renamed && isElement(f) {
+			full := name
+			if qualifier != "" {
+				full = qualifier + "." + name
+			}
+			c.unmatched[full] = true
+		}
+		fieldType, fieldQualifier := typeName(f.Type)
+		if fieldQualifier == "" {
+			fieldQualifier = qualifier
+		}
+		c.walk(fieldQualifier, fieldType, renamed)
+	}
+}
+
+// isElement tells if the field f is decoded from an element of its own
+func isElement(f *ast.Field) bool {
+	name, options := xmlTag(f)
+	if name == "-" || name == "XMLName" {
+		return false
+	}
+	for _, o := range strings.Split(options, ",") {
+		switch o {
+		case "attr", "chardata", "innerxml", "comment", "any":
+			return false
+		}
+	}
+	return f.Names[0].Name != "XMLName"
+}
+
+// elementPrefixed tells if the field f names its element with a prefix
+func elementPrefixed(f *ast.Field) bool {
+	name, _ := xmlTag(f)
+	return strings.Contains(name, ":")
+}
+
+// xmlTag returns the name and the options of the xml tag of f
+func xmlTag(f *ast.Field) (string, string) {
+	if f.Tag == nil {
+		return "", ""
+	}
+	tag := reflect.StructTag(strings.Trim(f.Tag.Value, "`")).Get("xml")
+	name, options, _ := strings.Cut(tag, ",")
+	return name, options
+}
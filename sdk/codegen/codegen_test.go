@@ -0,0 +1,81 @@
+// Copyright (c) 2022 Jean-Francois SMIGIELSKI
+// Distributed under the MIT License
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/BalkarSandhu/go-onvif/onvif"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// generate returns the types of the service of the test WSDL file, the
+// namespace of testdata/ext being mapped to its package
+func generate(t *testing.T, file string, values map[string]bool) ([]byte, error) {
+	t.Helper()
+	svc, err := readService(filepath.Join("testdata", file))
+	require.NoError(t, err)
+	prefix, _ := servicePrefix(svc, onvif.Xlmns)
+
+	xsdTypes, err := readPackage(filepath.Join("..", "..", "xsd"), "")
+	require.NoError(t, err)
+	onvifTypes, err := readPackage(filepath.Join("..", "..", "xsd", "onvif"), "")
+	require.NoError(t, err)
+	onvifTypes.path = onvifImport
+	ext, err := readPackage(filepath.Join("testdata", "ext"), "")
+	require.NoError(t, err)
+	ext.path = "example.com/ext"
+
+	packages := map[string]*goPackage{onvifNamespace: onvifTypes, "http://example.com/ext": ext}
+	return generateTypes(svc, "sample", prefix, xsdTypes, packages, values)
+}
+
+func TestGenerateTypes(t *testing.T) {
+	src, err := generate(t, "sample.wsdl", map[string]bool{"SetSettings.ForcePersistence": true})
+	require.NoError(t, err)
+
+	golden := filepath.Join("testdata", "sample.golden")
+	if *update {
+		require.NoError(t, os.WriteFile(golden, src, 0644))
+	}
+	want, err := os.ReadFile(golden)
+	require.NoError(t, err)
+	assert.Equal(t, string(want), string(src))
+}
+
+func TestGenerateTypesUnmatched(t *testing.T) {
+	_, err := generate(t, "unmatched.wsdl", nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ext.Qualified")
+}
+
+func TestServicePrefix(t *testing.T) {
+	xlmns := map[string]string{
+		"timg":  "http://www.onvif.org/ver20/imaging/wsdl",
+		"tt":    "http://www.onvif.org/ver10/schema",
+		"onvif": "http://www.onvif.org/ver10/schema",
+	}
+	for _, c := range []struct {
+		namespace, declared string
+		prefix              string
+		known               bool
+	}{
+		{"http://www.onvif.org/ver20/imaging/wsdl", "img", "timg", true},
+		{"http://www.onvif.org/ver10/schema", "", "onvif", true},
+		{"http://www.onvif.org/ver10/schema", "tt", "tt", true},
+		{"http://www.onvif.org/ver10/deviceIO/wsdl", "tmd", "tmd", false},
+		{"http://www.onvif.org/ver10/deviceIO/wsdl", "", "deviceio", false},
+	} {
+		prefix, known := servicePrefix(&service{namespace: c.namespace, prefix: c.declared}, xlmns)
+		assert.Equal(t, c.prefix, prefix, c.namespace)
+		assert.Equal(t, c.known, known, c.namespace)
+	}
+}
@@ -1,60 +1,140 @@
 // Copyright (c) 2022 Jean-Francois SMIGIELSKI
 // Distributed under the MIT License
 
+// Command codegen generates the SDK of the ONVIF services.
+//
+// Without -wsdl, it writes the Call_ wrapper of a single operation:
+//
+//	codegen <package> <struct package> <operation>
+//
+// With -wsdl, it writes the wrappers of every operation of the WSDL:
+//
+//	codegen -wsdl ../../docs/wsdl/imaging.wsdl <package> <struct package>
+//
+// With -wsdl and -types, it writes the types of the service, its request and
+// response structs included, into a single file of the package:
+//
+//	codegen -wsdl ../../docs/wsdl/imaging.wsdl -types -o types_auto.go <package>
+//
+// The optional elements of the requests are pointers when they are structs,
+// or omitted when empty otherwise. -values lists the Type.Field ones kept as
+// values and always sent, e.g. SetImagingSettings.ForcePersistence.
+//
+// The types of the ONVIF schema are the ones of xsd/onvif. -import maps the
+// namespaces of the other types to the packages declaring them, by their path
+// from the root of the module, e.g. http://docs.oasis-open.org/wsn/b-2=event.
+// The types decoded from the replies, the ones of xsd/onvif included, must
+// match their elements whatever the prefixes used by the device, else no
+// file is written.
+//
+// The elements of the requests are named with the prefix of onvif.Xlmns for
+// the namespace of the service, else with the one declared by the WSDL, which
+// must then be added to onvif.Xlmns.
+//
+// The struct package is the path of a package from the root of the module,
+// e.g. Imaging.
 package main
 
 import (
+	"bytes"
 	"flag"
+	"go/parser"
+	"go/token"
 	"log"
 	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
 	"text/template"
+
+	"github.com/BalkarSandhu/go-onvif/onvif"
 )
 
-var mainTemplate = `
+var mainTemplate = `// Code generated : DO NOT EDIT.
+// Copyright (c) 2022 Jean-Francois SMIGIELSKI
+// Distributed under the MIT License
+
 package {{.Package}}
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
-	"github.com/BalkarSandhu/go-onvif/{{.StructPackage}}"
+	{{if .StructAlias}}{{.StructName}} {{end}}"github.com/BalkarSandhu/go-onvif/{{.StructPackage}}"
 )
 
-// Call_{{.TypeRequest}} forwards the call to dev.CallMethod() then parses the payload of the reply as a {{.TypeReply}}.
-func Call_{{.TypeRequest}}(ctx context.Context, dev *onvif.Device, request {{.StructPackage}}.{{.TypeRequest}}) ({{.StructPackage}}.{{.TypeReply}}, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			{{.TypeReply}} {{.StructPackage}}.{{.TypeReply}}
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.{{.TypeReply}}, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "{{.TypeRequest}}")
-		return reply.Body.{{.TypeReply}}, errors.Annotate(err, "reply")
-	}
+// Call_{{.TypeRequest}} forwards the call to sdk.Call() then parses the payload of the reply as a {{.TypeReply}}.
+func Call_{{.TypeRequest}}(ctx context.Context, dev *onvif.Device, request {{.StructName}}.{{.TypeRequest}}) ({{.StructName}}.{{.TypeReply}}, error) {
+	return sdk.Call[{{.StructName}}.{{.TypeRequest}}, {{.StructName}}.{{.TypeReply}}](ctx, dev, request)
 }
 `
 
 type parserEnv struct {
 	Package       string
 	StructPackage string
+	StructName    string
+	StructAlias   bool
 	TypeReply     string
 	TypeRequest   string
 }
 
 func main() {
+	wsdl := flag.String("wsdl", "", "WSDL or XSD file of the service")
+	types := flag.Bool("types", false, "generate the types of the service instead of the wrappers of its operations")
+	output := flag.String("o", "types_auto.go", "file the types are written to")
+	values := flag.String("values", "", "comma-separated Type.Field optional elements of the requests kept as values and always sent")
+	imports := flag.String("import", "", "comma-separated namespace=package mappings of the types of other namespaces")
 	flag.Parse()
-	env := parserEnv{
-		Package:       flag.Arg(0),
-		StructPackage: flag.Arg(1),
-		TypeRequest:   flag.Arg(2),
-		TypeReply:     flag.Arg(2) + "Response",
+
+	root, err := moduleRoot()
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *wsdl == "" {
+		env := newEnv(root, flag.Arg(0), flag.Arg(1))
+		env.TypeRequest = flag.Arg(2)
+		env.TypeReply = flag.Arg(2) + "Response"
+		writeWrapper(env)
+		return
+	}
+
+	svc, err := readService(*wsdl)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	if *types {
+		writeTypes(root, svc, flag.Arg(0), *output, *values, *imports)
+		return
+	}
+
+	for _, op := range svc.operations {
+		if op.inputNamespace != svc.namespace || op.outputNamespace != svc.namespace {
+			continue
+		}
+		env := newEnv(root, flag.Arg(0), flag.Arg(1))
+		env.TypeRequest = exported(op.input)
+		env.TypeReply = exported(op.output)
+		writeWrapper(env)
+	}
+}
+
+func newEnv(root, pkg, structPackage string) parserEnv {
+	name, err := packageName(filepath.Join(root, filepath.FromSlash(structPackage)))
+	if err != nil {
+		log.Fatalln(err)
+	}
+	return parserEnv{
+		Package:       pkg,
+		StructPackage: structPackage,
+		StructName:    name,
+		StructAlias:   name != path.Base(structPackage),
 	}
+}
 
+func writeWrapper(env parserEnv) {
 	log.Println(env)
 
 	body, err := template.New("body").Parse(mainTemplate)
@@ -73,3 +153,131 @@ func main() {
 		log.Fatalln(err)
 	}
 }
+
+func writeTypes(root string, svc *service, pkg, output, values, imports string) {
+	prefix, declared := servicePrefix(svc, onvif.Xlmns)
+	if !declared {
+		log.Printf("%s is not in onvif.Xlmns: add it as %s for the requests to be sent", svc.namespace, prefix)
+	}
+
+	xsdTypes, err := readPackage(filepath.Join(root, "xsd"), "")
+	if err != nil {
+		log.Fatalln(err)
+	}
+	packages := map[string]*goPackage{}
+	if packages[onvifNamespace], err = readPackage(filepath.Join(root, "xsd", "onvif"), ""); err != nil {
+		log.Fatalln(err)
+	}
+	packages[onvifNamespace].path = onvifImport
+
+	out, err := filepath.Abs(output)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	for _, m := range strings.Split(imports, ",") {
+		if m == "" {
+			continue
+		}
+		ns, path, ok := strings.Cut(m, "=")
+		if !ok {
+			log.Fatalf("-import %s: expecting namespace=package", m)
+		}
+		dir := filepath.Join(root, filepath.FromSlash(path))
+		p, err := readPackage(dir, out)
+		if err != nil {
+			log.Fatalln(err)
+		}
+		// The types of the package written to are used without qualifier
+		if dir != filepath.Dir(out) {
+			p.path = modulePath + "/" + path
+		}
+		packages[ns] = p
+	}
+
+	kept := make(map[string]bool)
+	for _, v := range strings.Split(values, ",") {
+		if v != "" {
+			kept[v] = true
+		}
+	}
+
+	src, err := generateTypes(svc, pkg, prefix, xsdTypes, packages, kept)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	if err := os.WriteFile(output, src, 0644); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+// servicePrefix returns the prefix the elements of the service are named
+// with, telling if xlmns declares it: the one of xlmns for the namespace of
+// the service, else the one declared by the WSDL, else the last segment of
+// the namespace, e.g. deviceio for http://www.onvif.org/ver10/deviceIO/wsdl
+func servicePrefix(svc *service, xlmns map[string]string) (string, bool) {
+	if svc.prefix != "" && xlmns[svc.prefix] == svc.namespace {
+		return svc.prefix, true
+	}
+	var known []string
+	for p, ns := range xlmns {
+		if ns == svc.namespace {
+			known = append(known, p)
+		}
+	}
+	if len(known) > 0 {
+		sort.Strings(known)
+		return known[0], true
+	}
+	if svc.prefix != "" {
+		return svc.prefix, false
+	}
+	segments := strings.FieldsFunc(svc.namespace, func(r rune) bool { return r == '/' || r == ':' })
+	for i := len(segments) - 1; i >= 0; i-- {
+		if segments[i] != "wsdl" {
+			return strings.ToLower(identifier(segments[i])), false
+		}
+	}
+	return "ns", false
+}
+
+// moduleRoot returns the directory of the go.mod of the working directory
+func moduleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", os.ErrNotExist
+		}
+		dir = parent
+	}
+}
+
+// packageName returns the name of the Go package in dir, which may differ
+// from the name of the directory
+func packageName(dir string) (string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return "", err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), e.Name(), bytes.NewReader(src), parser.PackageClauseOnly)
+		if err != nil {
+			return "", err
+		}
+		return f.Name.Name, nil
+	}
+	return strings.ToLower(filepath.Base(dir)), nil
+}
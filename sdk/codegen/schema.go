@@ -0,0 +1,224 @@
+// Copyright (c) 2022 Jean-Francois SMIGIELSKI
+// Distributed under the MIT License
+
+package main
+
+import (
+	"encoding/xml"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Namespaces the generated types are mapped to existing packages for
+const (
+	xmlSchemaNamespace = "http://www.w3.org/2001/XMLSchema"
+	onvifNamespace     = "http://www.onvif.org/ver10/schema"
+)
+
+// node is an element of a WSDL or XSD document. The subset of XML Schema
+// used by the ONVIF services is read from this generic tree.
+type node struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	Children []*node    `xml:",any"`
+	Text     string     `xml:",chardata"`
+
+	parent *node
+}
+
+func (n *node) setParents() {
+	for _, c := range n.Children {
+		c.parent = n
+		c.setParents()
+	}
+}
+
+// is tells if n is named local, whatever its namespace
+func (n *node) is(local string) bool {
+	return n.XMLName.Local == local
+}
+
+// attr returns the value of the unqualified attribute local
+func (n *node) attr(local string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "" && a.Name.Local == local {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+func (n *node) child(local string) *node {
+	for _, c := range n.Children {
+		if c.is(local) {
+			return c
+		}
+	}
+	return nil
+}
+
+func (n *node) children(local string) []*node {
+	var res []*node
+	for _, c := range n.Children {
+		if c.is(local) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// resolve returns the namespace and the local name of the QName value of an
+// attribute of n, e.g. tt:ReferenceToken
+func (n *node) resolve(qname string) (string, string) {
+	prefix, local := "", qname
+	if i := strings.IndexByte(qname, ':'); i >= 0 {
+		prefix, local = qname[:i], qname[i+1:]
+	}
+	for e := n; e != nil; e = e.parent {
+		for _, a := range e.Attrs {
+			if (prefix == "" && a.Name.Space == "" && a.Name.Local == "xmlns") ||
+				(prefix != "" && a.Name.Space == "xmlns" && a.Name.Local == prefix) {
+				return a.Value, local
+			}
+		}
+	}
+	return "", local
+}
+
+// prefixOf returns the prefix n or one of its children declares for
+// namespace, if any
+func (n *node) prefixOf(namespace string) string {
+	for _, a := range n.Attrs {
+		if a.Name.Space == "xmlns" && a.Value == namespace {
+			return a.Name.Local
+		}
+	}
+	for _, c := range n.Children {
+		if p := c.prefixOf(namespace); p != "" {
+			return p
+		}
+	}
+	return ""
+}
+
+// doc returns the documentation of n, with its spaces normalized
+func (n *node) doc() string {
+	d := n.child("documentation")
+	if annotation := n.child("annotation"); annotation != nil {
+		d = annotation.child("documentation")
+	}
+	if d == nil {
+		return ""
+	}
+	return strings.Join(strings.Fields(d.Text), " ")
+}
+
+// occurs returns the minOccurs and maxOccurs of n, -1 standing for unbounded
+func (n *node) occurs() (int, int) {
+	min, max := 1, 1
+	if v := n.attr("minOccurs"); v != "" {
+		min, _ = strconv.Atoi(v)
+	}
+	if v := n.attr("maxOccurs"); v == "unbounded" {
+		max = -1
+	} else if v != "" {
+		max, _ = strconv.Atoi(v)
+	}
+	return min, max
+}
+
+// service is what a WSDL, or a standalone XSD, declares in its target namespace
+type service struct {
+	file       string
+	namespace  string
+	prefix     string
+	elements   map[string]*node
+	types      map[string]*node
+	typeOrder  []*node
+	operations []operation
+}
+
+// operation of a port type, with the names of its request and response elements
+type operation struct {
+	name, doc       string
+	input, output   string
+	inputNamespace  string
+	outputNamespace string
+}
+
+// readService reads the schemas and the operations of a WSDL or XSD file
+func readService(path string) (*service, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	root := new(node)
+	if err := xml.Unmarshal(data, root); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	root.setParents()
+
+	svc := &service{
+		file:      path,
+		namespace: root.attr("targetNamespace"),
+		prefix:    root.prefixOf(root.attr("targetNamespace")),
+		elements:  make(map[string]*node),
+		types:     make(map[string]*node),
+	}
+
+	schemas := []*node{root}
+	if !root.is("schema") {
+		schemas = nil
+		for _, types := range root.children("types") {
+			schemas = append(schemas, types.children("schema")...)
+		}
+	}
+	for _, schema := range schemas {
+		if schema.attr("targetNamespace") != svc.namespace {
+			continue
+		}
+		for _, c := range schema.Children {
+			name := c.attr("name")
+			switch {
+			case name == "":
+			case c.is("element"):
+				svc.elements[name] = c
+			case c.is("complexType"), c.is("simpleType"):
+				svc.types[name] = c
+				svc.typeOrder = append(svc.typeOrder, c)
+			}
+		}
+	}
+
+	// Message name -> element of its part
+	messages := make(map[string]*node)
+	for _, m := range root.children("message") {
+		if part := m.child("part"); part != nil {
+			messages[m.attr("name")] = part
+		}
+	}
+	seen := make(map[string]bool)
+	for _, portType := range root.children("portType") {
+		for _, op := range portType.children("operation") {
+			name := op.attr("name")
+			input, output := op.child("input"), op.child("output")
+			if seen[name] || input == nil || output == nil {
+				continue
+			}
+			_, inMessage := input.resolve(input.attr("message"))
+			_, outMessage := output.resolve(output.attr("message"))
+			inPart, outPart := messages[inMessage], messages[outMessage]
+			if inPart == nil || outPart == nil {
+				continue
+			}
+			o := operation{name: name, doc: op.doc()}
+			o.inputNamespace, o.input = inPart.resolve(inPart.attr("element"))
+			o.outputNamespace, o.output = outPart.resolve(outPart.attr("element"))
+			seen[name] = true
+			svc.operations = append(svc.operations, o)
+		}
+	}
+	return svc, nil
+}
@@ -0,0 +1,11 @@
+// Package ext declares the types of the namespace the test WSDLs map to a Go package
+package ext
+
+type Detail struct {
+	Text string `xml:"Text"`
+}
+
+// Qualified names its elements with a prefix, without UnmarshalXML
+type Qualified struct {
+	Text string `xml:"ext:Text"`
+}
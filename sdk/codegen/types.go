@@ -0,0 +1,674 @@
+// Copyright (c) 2022 Jean-Francois SMIGIELSKI
+// Distributed under the MIT License
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const (
+	modulePath  = "github.com/BalkarSandhu/go-onvif"
+	xsdImport   = modulePath + "/xsd"
+	onvifImport = modulePath + "/xsd/onvif"
+)
+
+// goPackage is a Go package the types of a namespace are mapped to
+type goPackage struct {
+	// path is the import path of the package, empty for the package the
+	// types are written to
+	path string
+	name string
+	// types tells for each type if it is a struct
+	types   map[string]bool
+	structs map[string]*ast.StructType
+	// decoders are the ways the types with an UnmarshalXML method decode
+	// their elements
+	decoders map[string]decoding
+}
+
+// decoding is the way an UnmarshalXML method decodes the elements of its type
+type decoding int
+
+const (
+	// decodeCustom stands for an UnmarshalXML which decodes the elements itself
+	decodeCustom decoding = iota
+	// decodeQualified stands for onvif.DecodeQualified, the elements being
+	// renamed with the prefix of the type
+	decodeQualified
+	// decodeUnqualified stands for the decoding of elements named without
+	// prefix inside a type decoded with onvif.DecodeQualified
+	decodeUnqualified
+)
+
+// readPackage reads the types declared by the Go package in dir, but the ones
+// of the file skip
+func readPackage(dir, skip string) (*goPackage, error) {
+	pkgs, err := parser.ParseDir(token.NewFileSet(), dir, func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && filepath.Join(dir, fi.Name()) != skip
+	}, 0)
+	if err != nil {
+		return nil, err
+	}
+	p := &goPackage{
+		name:     strings.ToLower(filepath.Base(dir)),
+		types:    make(map[string]bool),
+		structs:  make(map[string]*ast.StructType),
+		decoders: make(map[string]decoding),
+	}
+	for name, pkg := range pkgs {
+		p.name = name
+		for _, f := range pkg.Files {
+			p.addFile(f)
+		}
+	}
+	return p, nil
+}
+
+// addFile adds the types declared by f and their UnmarshalXML methods
+func (p *goPackage) addFile(f *ast.File) {
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				ts := spec.(*ast.TypeSpec)
+				st, isStruct := ts.Type.(*ast.StructType)
+				p.types[ts.Name.Name] = isStruct
+				if isStruct {
+					p.structs[ts.Name.Name] = st
+				}
+			}
+		case *ast.FuncDecl:
+			if decl.Name.Name != "UnmarshalXML" || decl.Recv == nil || len(decl.Recv.List) != 1 {
+				continue
+			}
+			recv, _ := typeName(decl.Recv.List[0].Type)
+			how := decodeCustom
+			ast.Inspect(decl.Body, func(n ast.Node) bool {
+				var name string
+				switch n := n.(type) {
+				case *ast.Ident:
+					name = n.Name
+				case *ast.SelectorExpr:
+					name = n.Sel.Name
+				}
+				switch name {
+				case "decodeQualified", "DecodeQualified":
+					how = decodeQualified
+				case "decodeUnqualified":
+					how = decodeUnqualified
+				}
+				return true
+			})
+			p.decoders[recv] = how
+		}
+	}
+}
+
+// typeName returns the name of the type of expr and its package qualifier,
+// without its pointers and slices
+func typeName(expr ast.Expr) (string, string) {
+	for {
+		switch e := expr.(type) {
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ArrayType:
+			expr = e.Elt
+		case *ast.Ident:
+			return e.Name, ""
+		case *ast.SelectorExpr:
+			if x, ok := e.X.(*ast.Ident); ok {
+				return e.Sel.Name, x.Name
+			}
+			return "", ""
+		default:
+			return "", ""
+		}
+	}
+}
+
+// typeGenerator writes the Go types of a service: the request and response
+// structs of its operations, then the types of its namespace. The types of
+// the ONVIF schema are the ones of xsd/onvif, the built-in types of XML
+// Schema the ones of xsd, the types of other namespaces the ones of the
+// packages they are mapped to.
+//
+// As in the hand-written packages, the requests and the types only sent in
+// requests name their elements with the prefix of the namespace, so that
+// they are marshaled in it, while the other types name them without prefix,
+// so that they are decoded whatever the prefixes used by the device. The
+// types both sent and received name them with the prefix, and decode them
+// with onvif.DecodeQualified.
+type typeGenerator struct {
+	svc      *service
+	pkg      string
+	prefix   string
+	xsd      *goPackage
+	packages map[string]*goPackage
+	request  map[string]bool
+	shared   map[string]bool
+	values   map[string]bool
+
+	out     bytes.Buffer
+	queue   []pendingType
+	emitted map[string]bool
+	imports map[string]bool
+	missing map[string]bool
+}
+
+type pendingType struct {
+	name    string
+	def     *node
+	request bool
+}
+
+type field struct {
+	name, typ, tag string
+	embedded       bool
+}
+
+// generateTypes returns the source of the types of svc, the types of the
+// other namespaces being the ones of packages
+func generateTypes(svc *service, pkg, prefix string, xsdTypes *goPackage, packages map[string]*goPackage, values map[string]bool) ([]byte, error) {
+	g := &typeGenerator{
+		svc:      svc,
+		pkg:      pkg,
+		prefix:   prefix,
+		xsd:      xsdTypes,
+		packages: packages,
+		values:   values,
+		emitted:  make(map[string]bool),
+		imports:  make(map[string]bool),
+		missing:  make(map[string]bool),
+	}
+	g.request, g.shared = g.directions()
+
+	for _, op := range svc.operations {
+		if op.inputNamespace == svc.namespace {
+			g.element(op.input, true, exported(op.input)+" is the request of the "+op.name+" operation. "+op.doc)
+		}
+		if op.outputNamespace == svc.namespace {
+			g.element(op.output, false, exported(op.output)+" is the reply to the "+op.name+" operation.")
+		}
+		g.flush()
+	}
+	for _, def := range svc.typeOrder {
+		name := def.attr("name")
+		if def.is("simpleType") {
+			g.simpleType(name, def)
+		} else {
+			g.complexType(exported(name), def, g.request[name] || g.shared[name], exported(name)+" type. "+def.doc())
+			if g.shared[name] {
+				g.unmarshaler(exported(name))
+			}
+		}
+		g.flush()
+	}
+
+	if len(g.missing) > 0 {
+		var missing []string
+		for name := range g.missing {
+			missing = append(missing, name)
+		}
+		sort.Strings(missing)
+		return nil, fmt.Errorf("types without Go declaration: %s", strings.Join(missing, ", "))
+	}
+
+	var src bytes.Buffer
+	fmt.Fprintf(&src, "// Code generated by sdk/codegen from %s : DO NOT EDIT.\n\npackage %s\n\n", filepath.Base(svc.file), pkg)
+	if len(g.imports) > 0 {
+		src.WriteString("import (\n")
+		if g.imports["encoding/xml"] {
+			src.WriteString("\t\"encoding/xml\"\n\n")
+		}
+		var paths []string
+		for path := range g.imports {
+			if path != "encoding/xml" {
+				paths = append(paths, path)
+			}
+		}
+		sort.Strings(paths)
+		for _, path := range paths {
+			fmt.Fprintf(&src, "\t%q\n", path)
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(g.out.Bytes())
+
+	formatted, err := format.Source(src.Bytes())
+	if err != nil {
+		return src.Bytes(), err
+	}
+	if err := g.checkReplies(formatted); err != nil {
+		return nil, err
+	}
+	return formatted, nil
+}
+
+// directions returns the types of the namespace reached from the requests
+// only, and the ones reached from both the requests and the responses
+func (g *typeGenerator) directions() (map[string]bool, map[string]bool) {
+	var requests, responses []*node
+	for _, op := range g.svc.operations {
+		if e := g.svc.elements[op.input]; e != nil && op.inputNamespace == g.svc.namespace {
+			requests = append(requests, e)
+		}
+		if e := g.svc.elements[op.output]; e != nil && op.outputNamespace == g.svc.namespace {
+			responses = append(responses, e)
+		}
+	}
+	sent, received := g.reached(requests), g.reached(responses)
+	shared := make(map[string]bool)
+	for name := range received {
+		if sent[name] {
+			shared[name] = true
+			delete(sent, name)
+		}
+	}
+	return sent, shared
+}
+
+// reached returns the names of the types of the namespace used by roots
+func (g *typeGenerator) reached(roots []*node) map[string]bool {
+	visited := make(map[string]bool)
+	var walk func(n *node)
+	walk = func(n *node) {
+		for _, attr := range []string{"type", "base", "ref"} {
+			v := n.attr(attr)
+			if v == "" {
+				continue
+			}
+			ns, local := n.resolve(v)
+			if ns != g.svc.namespace {
+				continue
+			}
+			if attr == "ref" {
+				if e := g.svc.elements[local]; e != nil && !visited["element "+local] {
+					visited["element "+local] = true
+					walk(e)
+				}
+			} else if t := g.svc.types[local]; t != nil && !visited[local] {
+				visited[local] = true
+				walk(t)
+			}
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	for _, root := range roots {
+		walk(root)
+	}
+	return visited
+}
+
+// element writes the struct of a request or response element
+func (g *typeGenerator) element(name string, request bool, doc string) {
+	def := g.svc.elements[name]
+	if def == nil {
+		return
+	}
+	goName := exported(name)
+	var fields []field
+	if request {
+		fields = append(fields, field{name: "XMLName", typ: "string", tag: g.prefix + ":" + name})
+	}
+	if t := def.attr("type"); t != "" {
+		typ, _ := g.goType(def, t)
+		fields = append(fields, field{typ: typ, embedded: true})
+		g.writeStruct(goName, doc, fields)
+		return
+	}
+	if ct := def.child("complexType"); ct != nil {
+		fields = append(fields, g.fields(ct, goName, request)...)
+	}
+	g.writeStruct(goName, doc, fields)
+}
+
+// unmarshaler writes the UnmarshalXML method of a type both sent and received
+func (g *typeGenerator) unmarshaler(name string) {
+	g.imports["encoding/xml"] = true
+	g.imports[onvifImport] = true
+	fmt.Fprintf(&g.out, `// UnmarshalXML decodes a %[1]s, whatever the prefixes used by the device
+func (v *%[1]s) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain %[1]s
+	return onvif.DecodeQualified(d, start, (*plain)(v), %[2]q, %[3]q)
+}
+
+`, name, g.svc.namespace, g.prefix)
+}
+
+func (g *typeGenerator) complexType(name string, def *node, request bool, doc string) {
+	g.writeStruct(name, doc, g.fields(def, name, request))
+}
+
+// flush writes the anonymous types met meanwhile
+func (g *typeGenerator) flush() {
+	for len(g.queue) > 0 {
+		t := g.queue[0]
+		g.queue = g.queue[1:]
+		g.complexType(t.name, t.def, t.request, t.name+" type. "+t.def.doc())
+	}
+}
+
+func (g *typeGenerator) writeStruct(name, doc string, fields []field) {
+	if g.emitted[name] {
+		return
+	}
+	g.emitted[name] = true
+	g.comment(doc)
+	fmt.Fprintf(&g.out, "type %s struct {\n", name)
+	for _, f := range fields {
+		if f.embedded {
+			fmt.Fprintf(&g.out, "\t%s\n", f.typ)
+		} else {
+			fmt.Fprintf(&g.out, "\t%s %s `xml:%q`\n", f.name, f.typ, f.tag)
+		}
+	}
+	g.out.WriteString("}\n\n")
+}
+
+// fields returns the fields of the complex type def, named owner in Go
+func (g *typeGenerator) fields(def *node, owner string, request bool) []field {
+	var fields []field
+	add := func(f field) {
+		for _, existing := range fields {
+			if !f.embedded && existing.name == f.name {
+				return
+			}
+		}
+		fields = append(fields, f)
+	}
+
+	content := def
+	if cc := def.child("complexContent"); cc != nil {
+		if ext := cc.child("extension"); ext != nil {
+			if base := ext.attr("base"); base != "" {
+				if ns, local := ext.resolve(base); ns != xmlSchemaNamespace || local != "anyType" {
+					typ, _ := g.goType(ext, base)
+					add(field{typ: typ, embedded: true})
+				}
+			}
+			content = ext
+		} else if r := cc.child("restriction"); r != nil {
+			content = r
+		}
+	} else if sc := def.child("simpleContent"); sc != nil {
+		content = sc.child("extension")
+		if content == nil {
+			content = sc.child("restriction")
+		}
+		if content == nil {
+			return nil
+		}
+		typ, _ := g.goType(content, content.attr("base"))
+		add(field{name: "Value", typ: typ, tag: ",chardata"})
+	}
+
+	for _, f := range g.attributes(content, request) {
+		add(f)
+	}
+	for _, f := range g.particles(content, owner, request, false, false) {
+		add(f)
+	}
+
+	// The content of a wildcard is kept as XML when it is the only content
+	only := true
+	for _, f := range fields {
+		if f.embedded || (f.tag != ",innerxml" && !strings.HasSuffix(f.tag, ",attr") && !strings.HasSuffix(f.tag, ",attr,omitempty")) {
+			only = false
+		}
+	}
+	if !only {
+		kept := fields[:0]
+		for _, f := range fields {
+			if f.tag != ",innerxml" {
+				kept = append(kept, f)
+			}
+		}
+		fields = kept
+	}
+	return fields
+}
+
+func (g *typeGenerator) attributes(n *node, request bool) []field {
+	var fields []field
+	for _, a := range n.children("attribute") {
+		name := a.attr("name")
+		typ := "xsd.AnySimpleType"
+		switch {
+		case a.attr("ref") != "":
+			_, name = a.resolve(a.attr("ref"))
+		case a.attr("type") != "":
+			var isStruct bool
+			if typ, isStruct = g.goType(a, a.attr("type")); isStruct {
+				// A list type declared as a struct, e.g. tt:StringAttrList
+				typ = "xsd.AnySimpleType"
+				g.imports[xsdImport] = true
+			}
+		case a.child("simpleType") != nil:
+			typ = g.simpleBase(a.child("simpleType"))
+		default:
+			g.imports[xsdImport] = true
+		}
+		tag := name + ",attr"
+		if request && a.attr("use") != "required" {
+			tag += ",omitempty"
+		}
+		fields = append(fields, field{name: exported(name), typ: typ, tag: tag})
+	}
+	return fields
+}
+
+// particles returns the fields of the elements of a sequence, choice or all
+func (g *typeGenerator) particles(n *node, owner string, request, optional, many bool) []field {
+	var fields []field
+	for _, c := range n.Children {
+		min, max := c.occurs()
+		switch {
+		case c.is("sequence"), c.is("all"), c.is("choice"):
+			fields = append(fields, g.particles(c, owner, request, optional || min == 0 || c.is("choice"), many || max != 1)...)
+		case c.is("element"):
+			fields = append(fields, g.elementField(c, owner, request, optional || min == 0 || n.is("choice"), many || max != 1))
+		case c.is("any") && min > 0 && !optional:
+			// Extension points are optional wildcards and are skipped
+			fields = append(fields, field{name: "Any", typ: "string", tag: ",innerxml"})
+		}
+	}
+	return fields
+}
+
+func (g *typeGenerator) elementField(c *node, owner string, request, optional, many bool) field {
+	def, name := c, c.attr("name")
+	var typ string
+	var isStruct bool
+	if ref := c.attr("ref"); ref != "" {
+		ns, local := c.resolve(ref)
+		name = local
+		if e := g.svc.elements[local]; ns == g.svc.namespace && e != nil {
+			def = e
+		} else {
+			typ, isStruct = g.goType(c, ref)
+		}
+	}
+	if typ == "" {
+		typ, isStruct = g.elementType(def, owner+exported(name), request)
+	}
+
+	tag := name
+	if request {
+		tag = g.prefix + ":" + name
+	}
+	switch {
+	case many:
+		typ = "[]" + typ
+	case g.values[owner+"."+exported(name)]:
+		// Kept as a value always sent, as in the hand-written types
+	case optional && request && isStruct:
+		typ = "*" + typ
+	case optional && request:
+		tag += ",omitempty"
+	}
+	return field{name: exported(name), typ: typ, tag: tag}
+}
+
+// elementType returns the Go type of the element def, declaring its
+// anonymous type as nested if any
+func (g *typeGenerator) elementType(def *node, nested string, request bool) (string, bool) {
+	if t := def.attr("type"); t != "" {
+		return g.goType(def, t)
+	}
+	if ct := def.child("complexType"); ct != nil {
+		g.queue = append(g.queue, pendingType{name: nested, def: ct, request: request})
+		return nested, true
+	}
+	if st := def.child("simpleType"); st != nil {
+		return g.simpleBase(st), false
+	}
+	g.imports[xsdImport] = true
+	return "xsd.AnyType", false
+}
+
+// goType returns the Go type of the XML Schema type named qname, telling if it is a struct
+func (g *typeGenerator) goType(n *node, qname string) (string, bool) {
+	ns, local := n.resolve(qname)
+	switch ns {
+	case xmlSchemaNamespace:
+		g.imports[xsdImport] = true
+		name := exported(local)
+		if _, ok := g.xsd.types[name]; !ok {
+			g.missing["xs:"+local] = true
+		}
+		return "xsd." + name, false
+	case g.svc.namespace:
+		def := g.svc.types[local]
+		if def == nil {
+			g.missing[g.prefix+":"+local] = true
+		}
+		return exported(local), def != nil && def.is("complexType")
+	}
+	p := g.packages[ns]
+	if p == nil {
+		g.missing["{"+ns+"}"+local] = true
+		return exported(local), false
+	}
+	isStruct, ok := p.types[local]
+	if !ok {
+		g.missing[p.name+"."+local] = true
+	}
+	if p.path == "" {
+		return local, isStruct
+	}
+	g.imports[p.path] = true
+	return p.name + "." + local, isStruct
+}
+
+// simpleBase returns the Go type a simple type derives from
+func (g *typeGenerator) simpleBase(st *node) string {
+	if r := st.child("restriction"); r != nil {
+		if base := r.attr("base"); base != "" {
+			typ, _ := g.goType(r, base)
+			return typ
+		}
+		if inner := r.child("simpleType"); inner != nil {
+			return g.simpleBase(inner)
+		}
+	}
+	// A list or a union is kept as its lexical form
+	g.imports[xsdImport] = true
+	return "xsd.AnySimpleType"
+}
+
+var stringTypes = map[string]bool{
+	"xsd.String": true, "xsd.NormalizedString": true, "xsd.Token": true, "xsd.Name": true,
+	"xsd.NCName": true, "xsd.NMTOKEN": true, "xsd.AnyURI": true, "xsd.QName": true,
+}
+
+func (g *typeGenerator) simpleType(name string, def *node) {
+	goName := exported(name)
+	if g.emitted[goName] {
+		return
+	}
+	g.emitted[goName] = true
+	base := g.simpleBase(def)
+	g.comment(goName + " type. " + def.doc())
+	fmt.Fprintf(&g.out, "type %s %s\n\n", goName, base)
+
+	r := def.child("restriction")
+	if r == nil || !stringTypes[base] {
+		return
+	}
+	var consts []string
+	seen := make(map[string]bool)
+	for _, e := range r.children("enumeration") {
+		value := e.attr("value")
+		constName := goName + exported(identifier(value))
+		if seen[constName] {
+			continue
+		}
+		seen[constName] = true
+		consts = append(consts, fmt.Sprintf("\t%s %s = %q\n", constName, goName, value))
+	}
+	if len(consts) > 0 {
+		fmt.Fprintf(&g.out, "// Values of %s\nconst (\n%s)\n\n", goName, strings.Join(consts, ""))
+	}
+}
+
+// comment writes doc as a comment, wrapped
+func (g *typeGenerator) comment(doc string) {
+	line := "//"
+	for _, word := range strings.Fields(doc) {
+		if len(line)+1+len(word) > 100 && line != "//" {
+			g.out.WriteString(line + "\n")
+			line = "//"
+		}
+		line += " " + word
+	}
+	g.out.WriteString(line + "\n")
+}
+
+// exported returns name with its first letter in upper case
+func exported(name string) string {
+	if name == "" {
+		return name
+	}
+	r := []rune(identifier(name))
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
+
+// identifier returns s with the characters that may not be in a Go
+// identifier removed, the words they separate being capitalized
+func identifier(s string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range s {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_':
+			if upper {
+				r = unicode.ToUpper(r)
+			}
+			b.WriteRune(r)
+			upper = false
+		default:
+			upper = true
+		}
+	}
+	if b.Len() == 0 {
+		return "Empty"
+	}
+	return b.String()
+}
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_AddIPAddressFilter forwards the call to dev.CallMethod() then parses the payload of the reply as a AddIPAddressFilterResponse.
+// Call_AddIPAddressFilter forwards the call to sdk.Call() then parses the payload of the reply as a AddIPAddressFilterResponse.
 func Call_AddIPAddressFilter(ctx context.Context, dev *onvif.Device, request device.AddIPAddressFilter) (device.AddIPAddressFilterResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddIPAddressFilterResponse device.AddIPAddressFilterResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddIPAddressFilterResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddIPAddressFilter")
-		return reply.Body.AddIPAddressFilterResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.AddIPAddressFilter, device.AddIPAddressFilterResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_AddScopes forwards the call to dev.CallMethod() then parses the payload of the reply as a AddScopesResponse.
+// Call_AddScopes forwards the call to sdk.Call() then parses the payload of the reply as a AddScopesResponse.
 func Call_AddScopes(ctx context.Context, dev *onvif.Device, request device.AddScopes) (device.AddScopesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddScopesResponse device.AddScopesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddScopesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddScopes")
-		return reply.Body.AddScopesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.AddScopes, device.AddScopesResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_CreateCertificate forwards the call to dev.CallMethod() then parses the payload of the reply as a CreateCertificateResponse.
+// Call_CreateCertificate forwards the call to sdk.Call() then parses the payload of the reply as a CreateCertificateResponse.
 func Call_CreateCertificate(ctx context.Context, dev *onvif.Device, request device.CreateCertificate) (device.CreateCertificateResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			CreateCertificateResponse device.CreateCertificateResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.CreateCertificateResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "CreateCertificate")
-		return reply.Body.CreateCertificateResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.CreateCertificate, device.CreateCertificateResponse](ctx, dev, request)
 }
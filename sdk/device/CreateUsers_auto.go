@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_CreateUsers forwards the call to dev.CallMethod() then parses the payload of the reply as a CreateUsersResponse.
+// Call_CreateUsers forwards the call to sdk.Call() then parses the payload of the reply as a CreateUsersResponse.
 func Call_CreateUsers(ctx context.Context, dev *onvif.Device, request device.CreateUsers) (device.CreateUsersResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			CreateUsersResponse device.CreateUsersResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.CreateUsersResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "CreateUsers")
-		return reply.Body.CreateUsersResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.CreateUsers, device.CreateUsersResponse](ctx, dev, request)
 }
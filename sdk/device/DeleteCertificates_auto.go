@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_DeleteCertificates forwards the call to dev.CallMethod() then parses the payload of the reply as a DeleteCertificatesResponse.
+// Call_DeleteCertificates forwards the call to sdk.Call() then parses the payload of the reply as a DeleteCertificatesResponse.
 func Call_DeleteCertificates(ctx context.Context, dev *onvif.Device, request device.DeleteCertificates) (device.DeleteCertificatesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			DeleteCertificatesResponse device.DeleteCertificatesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.DeleteCertificatesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "DeleteCertificates")
-		return reply.Body.DeleteCertificatesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.DeleteCertificates, device.DeleteCertificatesResponse](ctx, dev, request)
 }
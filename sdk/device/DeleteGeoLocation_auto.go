@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_DeleteGeoLocation forwards the call to dev.CallMethod() then parses the payload of the reply as a DeleteGeoLocationResponse.
+// Call_DeleteGeoLocation forwards the call to sdk.Call() then parses the payload of the reply as a DeleteGeoLocationResponse.
 func Call_DeleteGeoLocation(ctx context.Context, dev *onvif.Device, request device.DeleteGeoLocation) (device.DeleteGeoLocationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			DeleteGeoLocationResponse device.DeleteGeoLocationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.DeleteGeoLocationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "DeleteGeoLocation")
-		return reply.Body.DeleteGeoLocationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.DeleteGeoLocation, device.DeleteGeoLocationResponse](ctx, dev, request)
 }
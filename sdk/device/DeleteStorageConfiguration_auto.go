@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_DeleteStorageConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a DeleteStorageConfigurationResponse.
+// Call_DeleteStorageConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a DeleteStorageConfigurationResponse.
 func Call_DeleteStorageConfiguration(ctx context.Context, dev *onvif.Device, request device.DeleteStorageConfiguration) (device.DeleteStorageConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			DeleteStorageConfigurationResponse device.DeleteStorageConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.DeleteStorageConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "DeleteStorageConfiguration")
-		return reply.Body.DeleteStorageConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.DeleteStorageConfiguration, device.DeleteStorageConfigurationResponse](ctx, dev, request)
 }
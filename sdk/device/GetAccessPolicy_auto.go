@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetAccessPolicy forwards the call to dev.CallMethod() then parses the payload of the reply as a GetAccessPolicyResponse.
+// Call_GetAccessPolicy forwards the call to sdk.Call() then parses the payload of the reply as a GetAccessPolicyResponse.
 func Call_GetAccessPolicy(ctx context.Context, dev *onvif.Device, request device.GetAccessPolicy) (device.GetAccessPolicyResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetAccessPolicyResponse device.GetAccessPolicyResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetAccessPolicyResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetAccessPolicy")
-		return reply.Body.GetAccessPolicyResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetAccessPolicy, device.GetAccessPolicyResponse](ctx, dev, request)
 }
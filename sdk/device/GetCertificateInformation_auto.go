@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetCertificateInformation forwards the call to dev.CallMethod() then parses the payload of the reply as a GetCertificateInformationResponse.
+// Call_GetCertificateInformation forwards the call to sdk.Call() then parses the payload of the reply as a GetCertificateInformationResponse.
 func Call_GetCertificateInformation(ctx context.Context, dev *onvif.Device, request device.GetCertificateInformation) (device.GetCertificateInformationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetCertificateInformationResponse device.GetCertificateInformationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetCertificateInformationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetCertificateInformation")
-		return reply.Body.GetCertificateInformationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetCertificateInformation, device.GetCertificateInformationResponse](ctx, dev, request)
 }
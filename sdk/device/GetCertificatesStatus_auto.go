@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetCertificatesStatus forwards the call to dev.CallMethod() then parses the payload of the reply as a GetCertificatesStatusResponse.
+// Call_GetCertificatesStatus forwards the call to sdk.Call() then parses the payload of the reply as a GetCertificatesStatusResponse.
 func Call_GetCertificatesStatus(ctx context.Context, dev *onvif.Device, request device.GetCertificatesStatus) (device.GetCertificatesStatusResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetCertificatesStatusResponse device.GetCertificatesStatusResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetCertificatesStatusResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetCertificatesStatus")
-		return reply.Body.GetCertificatesStatusResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetCertificatesStatus, device.GetCertificatesStatusResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetCertificates forwards the call to dev.CallMethod() then parses the payload of the reply as a GetCertificatesResponse.
+// Call_GetCertificates forwards the call to sdk.Call() then parses the payload of the reply as a GetCertificatesResponse.
 func Call_GetCertificates(ctx context.Context, dev *onvif.Device, request device.GetCertificates) (device.GetCertificatesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetCertificatesResponse device.GetCertificatesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetCertificatesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetCertificates")
-		return reply.Body.GetCertificatesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetCertificates, device.GetCertificatesResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDNS forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDNSResponse.
+// Call_GetDNS forwards the call to sdk.Call() then parses the payload of the reply as a GetDNSResponse.
 func Call_GetDNS(ctx context.Context, dev *onvif.Device, request device.GetDNS) (device.GetDNSResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDNSResponse device.GetDNSResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDNSResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDNS")
-		return reply.Body.GetDNSResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDNS, device.GetDNSResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDPAddresses forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDPAddressesResponse.
+// Call_GetDPAddresses forwards the call to sdk.Call() then parses the payload of the reply as a GetDPAddressesResponse.
 func Call_GetDPAddresses(ctx context.Context, dev *onvif.Device, request device.GetDPAddresses) (device.GetDPAddressesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDPAddressesResponse device.GetDPAddressesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDPAddressesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDPAddresses")
-		return reply.Body.GetDPAddressesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDPAddresses, device.GetDPAddressesResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDiscoveryMode forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDiscoveryModeResponse.
+// Call_GetDiscoveryMode forwards the call to sdk.Call() then parses the payload of the reply as a GetDiscoveryModeResponse.
 func Call_GetDiscoveryMode(ctx context.Context, dev *onvif.Device, request device.GetDiscoveryMode) (device.GetDiscoveryModeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDiscoveryModeResponse device.GetDiscoveryModeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDiscoveryModeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDiscoveryMode")
-		return reply.Body.GetDiscoveryModeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDiscoveryMode, device.GetDiscoveryModeResponse](ctx, dev, request)
 }
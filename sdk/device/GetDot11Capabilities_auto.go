@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDot11Capabilities forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDot11CapabilitiesResponse.
+// Call_GetDot11Capabilities forwards the call to sdk.Call() then parses the payload of the reply as a GetDot11CapabilitiesResponse.
 func Call_GetDot11Capabilities(ctx context.Context, dev *onvif.Device, request device.GetDot11Capabilities) (device.GetDot11CapabilitiesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDot11CapabilitiesResponse device.GetDot11CapabilitiesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDot11CapabilitiesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDot11Capabilities")
-		return reply.Body.GetDot11CapabilitiesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDot11Capabilities, device.GetDot11CapabilitiesResponse](ctx, dev, request)
 }
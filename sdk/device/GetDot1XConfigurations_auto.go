@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDot1XConfigurations forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDot1XConfigurationsResponse.
+// Call_GetDot1XConfigurations forwards the call to sdk.Call() then parses the payload of the reply as a GetDot1XConfigurationsResponse.
 func Call_GetDot1XConfigurations(ctx context.Context, dev *onvif.Device, request device.GetDot1XConfigurations) (device.GetDot1XConfigurationsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDot1XConfigurationsResponse device.GetDot1XConfigurationsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDot1XConfigurationsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDot1XConfigurations")
-		return reply.Body.GetDot1XConfigurationsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDot1XConfigurations, device.GetDot1XConfigurationsResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetDynamicDNS forwards the call to dev.CallMethod() then parses the payload of the reply as a GetDynamicDNSResponse.
+// Call_GetDynamicDNS forwards the call to sdk.Call() then parses the payload of the reply as a GetDynamicDNSResponse.
 func Call_GetDynamicDNS(ctx context.Context, dev *onvif.Device, request device.GetDynamicDNS) (device.GetDynamicDNSResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetDynamicDNSResponse device.GetDynamicDNSResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetDynamicDNSResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetDynamicDNS")
-		return reply.Body.GetDynamicDNSResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetDynamicDNS, device.GetDynamicDNSResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetEndpointReference forwards the call to dev.CallMethod() then parses the payload of the reply as a GetEndpointReferenceResponse.
+// Call_GetEndpointReference forwards the call to sdk.Call() then parses the payload of the reply as a GetEndpointReferenceResponse.
 func Call_GetEndpointReference(ctx context.Context, dev *onvif.Device, request device.GetEndpointReference) (device.GetEndpointReferenceResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetEndpointReferenceResponse device.GetEndpointReferenceResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetEndpointReferenceResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetEndpointReference")
-		return reply.Body.GetEndpointReferenceResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetEndpointReference, device.GetEndpointReferenceResponse](ctx, dev, request)
 }
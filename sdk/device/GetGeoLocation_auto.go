@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetGeoLocation forwards the call to dev.CallMethod() then parses the payload of the reply as a GetGeoLocationResponse.
+// Call_GetGeoLocation forwards the call to sdk.Call() then parses the payload of the reply as a GetGeoLocationResponse.
 func Call_GetGeoLocation(ctx context.Context, dev *onvif.Device, request device.GetGeoLocation) (device.GetGeoLocationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetGeoLocationResponse device.GetGeoLocationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetGeoLocationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetGeoLocation")
-		return reply.Body.GetGeoLocationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetGeoLocation, device.GetGeoLocationResponse](ctx, dev, request)
 }
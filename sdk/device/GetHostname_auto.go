@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetHostname forwards the call to dev.CallMethod() then parses the payload of the reply as a GetHostnameResponse.
+// Call_GetHostname forwards the call to sdk.Call() then parses the payload of the reply as a GetHostnameResponse.
 func Call_GetHostname(ctx context.Context, dev *onvif.Device, request device.GetHostname) (device.GetHostnameResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetHostnameResponse device.GetHostnameResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetHostnameResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetHostname")
-		return reply.Body.GetHostnameResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetHostname, device.GetHostnameResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetNTP forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNTPResponse.
+// Call_GetNTP forwards the call to sdk.Call() then parses the payload of the reply as a GetNTPResponse.
 func Call_GetNTP(ctx context.Context, dev *onvif.Device, request device.GetNTP) (device.GetNTPResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNTPResponse device.GetNTPResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNTPResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNTP")
-		return reply.Body.GetNTPResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetNTP, device.GetNTPResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetNetworkDefaultGateway forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNetworkDefaultGatewayResponse.
+// Call_GetNetworkDefaultGateway forwards the call to sdk.Call() then parses the payload of the reply as a GetNetworkDefaultGatewayResponse.
 func Call_GetNetworkDefaultGateway(ctx context.Context, dev *onvif.Device, request device.GetNetworkDefaultGateway) (device.GetNetworkDefaultGatewayResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNetworkDefaultGatewayResponse device.GetNetworkDefaultGatewayResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNetworkDefaultGatewayResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNetworkDefaultGateway")
-		return reply.Body.GetNetworkDefaultGatewayResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetNetworkDefaultGateway, device.GetNetworkDefaultGatewayResponse](ctx, dev, request)
 }
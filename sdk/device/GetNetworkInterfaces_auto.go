@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetNetworkInterfaces forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNetworkInterfacesResponse.
+// Call_GetNetworkInterfaces forwards the call to sdk.Call() then parses the payload of the reply as a GetNetworkInterfacesResponse.
 func Call_GetNetworkInterfaces(ctx context.Context, dev *onvif.Device, request device.GetNetworkInterfaces) (device.GetNetworkInterfacesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNetworkInterfacesResponse device.GetNetworkInterfacesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNetworkInterfacesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNetworkInterfaces")
-		return reply.Body.GetNetworkInterfacesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetNetworkInterfaces, device.GetNetworkInterfacesResponse](ctx, dev, request)
 }
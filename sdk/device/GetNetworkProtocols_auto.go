@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetNetworkProtocols forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNetworkProtocolsResponse.
+// Call_GetNetworkProtocols forwards the call to sdk.Call() then parses the payload of the reply as a GetNetworkProtocolsResponse.
 func Call_GetNetworkProtocols(ctx context.Context, dev *onvif.Device, request device.GetNetworkProtocols) (device.GetNetworkProtocolsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNetworkProtocolsResponse device.GetNetworkProtocolsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNetworkProtocolsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNetworkProtocols")
-		return reply.Body.GetNetworkProtocolsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetNetworkProtocols, device.GetNetworkProtocolsResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetRelayOutputs forwards the call to dev.CallMethod() then parses the payload of the reply as a GetRelayOutputsResponse.
+// Call_GetRelayOutputs forwards the call to sdk.Call() then parses the payload of the reply as a GetRelayOutputsResponse.
 func Call_GetRelayOutputs(ctx context.Context, dev *onvif.Device, request device.GetRelayOutputs) (device.GetRelayOutputsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetRelayOutputsResponse device.GetRelayOutputsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetRelayOutputsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetRelayOutputs")
-		return reply.Body.GetRelayOutputsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetRelayOutputs, device.GetRelayOutputsResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetRemoteDiscoveryMode forwards the call to dev.CallMethod() then parses the payload of the reply as a GetRemoteDiscoveryModeResponse.
+// Call_GetRemoteDiscoveryMode forwards the call to sdk.Call() then parses the payload of the reply as a GetRemoteDiscoveryModeResponse.
 func Call_GetRemoteDiscoveryMode(ctx context.Context, dev *onvif.Device, request device.GetRemoteDiscoveryMode) (device.GetRemoteDiscoveryModeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetRemoteDiscoveryModeResponse device.GetRemoteDiscoveryModeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetRemoteDiscoveryModeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetRemoteDiscoveryMode")
-		return reply.Body.GetRemoteDiscoveryModeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetRemoteDiscoveryMode, device.GetRemoteDiscoveryModeResponse](ctx, dev, request)
 }
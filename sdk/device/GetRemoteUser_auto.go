@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetRemoteUser forwards the call to dev.CallMethod() then parses the payload of the reply as a GetRemoteUserResponse.
+// Call_GetRemoteUser forwards the call to sdk.Call() then parses the payload of the reply as a GetRemoteUserResponse.
 func Call_GetRemoteUser(ctx context.Context, dev *onvif.Device, request device.GetRemoteUser) (device.GetRemoteUserResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetRemoteUserResponse device.GetRemoteUserResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetRemoteUserResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetRemoteUser")
-		return reply.Body.GetRemoteUserResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetRemoteUser, device.GetRemoteUserResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetServices forwards the call to dev.CallMethod() then parses the payload of the reply as a GetServicesResponse.
+// Call_GetServices forwards the call to sdk.Call() then parses the payload of the reply as a GetServicesResponse.
 func Call_GetServices(ctx context.Context, dev *onvif.Device, request device.GetServices) (device.GetServicesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetServicesResponse device.GetServicesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetServicesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetServices")
-		return reply.Body.GetServicesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetServices, device.GetServicesResponse](ctx, dev, request)
 }
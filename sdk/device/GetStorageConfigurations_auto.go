@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetStorageConfigurations forwards the call to dev.CallMethod() then parses the payload of the reply as a GetStorageConfigurationsResponse.
+// Call_GetStorageConfigurations forwards the call to sdk.Call() then parses the payload of the reply as a GetStorageConfigurationsResponse.
 func Call_GetStorageConfigurations(ctx context.Context, dev *onvif.Device, request device.GetStorageConfigurations) (device.GetStorageConfigurationsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetStorageConfigurationsResponse device.GetStorageConfigurationsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetStorageConfigurationsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetStorageConfigurations")
-		return reply.Body.GetStorageConfigurationsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetStorageConfigurations, device.GetStorageConfigurationsResponse](ctx, dev, request)
 }
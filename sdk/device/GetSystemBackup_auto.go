@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetSystemBackup forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSystemBackupResponse.
+// Call_GetSystemBackup forwards the call to sdk.Call() then parses the payload of the reply as a GetSystemBackupResponse.
 func Call_GetSystemBackup(ctx context.Context, dev *onvif.Device, request device.GetSystemBackup) (device.GetSystemBackupResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSystemBackupResponse device.GetSystemBackupResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSystemBackupResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSystemBackup")
-		return reply.Body.GetSystemBackupResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetSystemBackup, device.GetSystemBackupResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetSystemDateAndTime forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSystemDateAndTimeResponse.
+// Call_GetSystemDateAndTime forwards the call to sdk.Call() then parses the payload of the reply as a GetSystemDateAndTimeResponse.
 func Call_GetSystemDateAndTime(ctx context.Context, dev *onvif.Device, request device.GetSystemDateAndTime) (device.GetSystemDateAndTimeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSystemDateAndTimeResponse device.GetSystemDateAndTimeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSystemDateAndTimeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSystemDateAndTime")
-		return reply.Body.GetSystemDateAndTimeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetSystemDateAndTime, device.GetSystemDateAndTimeResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetSystemLog forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSystemLogResponse.
+// Call_GetSystemLog forwards the call to sdk.Call() then parses the payload of the reply as a GetSystemLogResponse.
 func Call_GetSystemLog(ctx context.Context, dev *onvif.Device, request device.GetSystemLog) (device.GetSystemLogResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSystemLogResponse device.GetSystemLogResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSystemLogResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSystemLog")
-		return reply.Body.GetSystemLogResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetSystemLog, device.GetSystemLogResponse](ctx, dev, request)
 }
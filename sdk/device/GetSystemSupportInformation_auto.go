@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetSystemSupportInformation forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSystemSupportInformationResponse.
+// Call_GetSystemSupportInformation forwards the call to sdk.Call() then parses the payload of the reply as a GetSystemSupportInformationResponse.
 func Call_GetSystemSupportInformation(ctx context.Context, dev *onvif.Device, request device.GetSystemSupportInformation) (device.GetSystemSupportInformationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSystemSupportInformationResponse device.GetSystemSupportInformationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSystemSupportInformationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSystemSupportInformation")
-		return reply.Body.GetSystemSupportInformationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetSystemSupportInformation, device.GetSystemSupportInformationResponse](ctx, dev, request)
 }
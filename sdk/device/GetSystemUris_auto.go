@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetSystemUris forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSystemUrisResponse.
+// Call_GetSystemUris forwards the call to sdk.Call() then parses the payload of the reply as a GetSystemUrisResponse.
 func Call_GetSystemUris(ctx context.Context, dev *onvif.Device, request device.GetSystemUris) (device.GetSystemUrisResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSystemUrisResponse device.GetSystemUrisResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSystemUrisResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSystemUris")
-		return reply.Body.GetSystemUrisResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetSystemUris, device.GetSystemUrisResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetUsers forwards the call to dev.CallMethod() then parses the payload of the reply as a GetUsersResponse.
+// Call_GetUsers forwards the call to sdk.Call() then parses the payload of the reply as a GetUsersResponse.
 func Call_GetUsers(ctx context.Context, dev *onvif.Device, request device.GetUsers) (device.GetUsersResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetUsersResponse device.GetUsersResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetUsersResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetUsers")
-		return reply.Body.GetUsersResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetUsers, device.GetUsersResponse](ctx, dev, request)
 }
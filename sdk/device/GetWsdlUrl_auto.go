@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetWsdlUrl forwards the call to dev.CallMethod() then parses the payload of the reply as a GetWsdlUrlResponse.
+// Call_GetWsdlUrl forwards the call to sdk.Call() then parses the payload of the reply as a GetWsdlUrlResponse.
 func Call_GetWsdlUrl(ctx context.Context, dev *onvif.Device, request device.GetWsdlUrl) (device.GetWsdlUrlResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetWsdlUrlResponse device.GetWsdlUrlResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetWsdlUrlResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetWsdlUrl")
-		return reply.Body.GetWsdlUrlResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetWsdlUrl, device.GetWsdlUrlResponse](ctx, dev, request)
 }
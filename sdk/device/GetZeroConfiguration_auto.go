@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_GetZeroConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a GetZeroConfigurationResponse.
+// Call_GetZeroConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a GetZeroConfigurationResponse.
 func Call_GetZeroConfiguration(ctx context.Context, dev *onvif.Device, request device.GetZeroConfiguration) (device.GetZeroConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetZeroConfigurationResponse device.GetZeroConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetZeroConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetZeroConfiguration")
-		return reply.Body.GetZeroConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.GetZeroConfiguration, device.GetZeroConfigurationResponse](ctx, dev, request)
 }
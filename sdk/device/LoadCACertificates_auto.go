@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_LoadCACertificates forwards the call to dev.CallMethod() then parses the payload of the reply as a LoadCACertificatesResponse.
+// Call_LoadCACertificates forwards the call to sdk.Call() then parses the payload of the reply as a LoadCACertificatesResponse.
 func Call_LoadCACertificates(ctx context.Context, dev *onvif.Device, request device.LoadCACertificates) (device.LoadCACertificatesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			LoadCACertificatesResponse device.LoadCACertificatesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.LoadCACertificatesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "LoadCACertificates")
-		return reply.Body.LoadCACertificatesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.LoadCACertificates, device.LoadCACertificatesResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_LoadCertificateWithPrivateKey forwards the call to dev.CallMethod() then parses the payload of the reply as a LoadCertificateWithPrivateKeyResponse.
+// Call_LoadCertificateWithPrivateKey forwards the call to sdk.Call() then parses the payload of the reply as a LoadCertificateWithPrivateKeyResponse.
 func Call_LoadCertificateWithPrivateKey(ctx context.Context, dev *onvif.Device, request device.LoadCertificateWithPrivateKey) (device.LoadCertificateWithPrivateKeyResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			LoadCertificateWithPrivateKeyResponse device.LoadCertificateWithPrivateKeyResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.LoadCertificateWithPrivateKeyResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "LoadCertificateWithPrivateKey")
-		return reply.Body.LoadCertificateWithPrivateKeyResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.LoadCertificateWithPrivateKey, device.LoadCertificateWithPrivateKeyResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_RemoveIPAddressFilter forwards the call to dev.CallMethod() then parses the payload of the reply as a RemoveIPAddressFilterResponse.
+// Call_RemoveIPAddressFilter forwards the call to sdk.Call() then parses the payload of the reply as a RemoveIPAddressFilterResponse.
 func Call_RemoveIPAddressFilter(ctx context.Context, dev *onvif.Device, request device.RemoveIPAddressFilter) (device.RemoveIPAddressFilterResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemoveIPAddressFilterResponse device.RemoveIPAddressFilterResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemoveIPAddressFilterResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemoveIPAddressFilter")
-		return reply.Body.RemoveIPAddressFilterResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.RemoveIPAddressFilter, device.RemoveIPAddressFilterResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_RemoveScopes forwards the call to dev.CallMethod() then parses the payload of the reply as a RemoveScopesResponse.
+// Call_RemoveScopes forwards the call to sdk.Call() then parses the payload of the reply as a RemoveScopesResponse.
 func Call_RemoveScopes(ctx context.Context, dev *onvif.Device, request device.RemoveScopes) (device.RemoveScopesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemoveScopesResponse device.RemoveScopesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemoveScopesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemoveScopes")
-		return reply.Body.RemoveScopesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.RemoveScopes, device.RemoveScopesResponse](ctx, dev, request)
 }
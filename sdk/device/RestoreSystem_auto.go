@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_RestoreSystem forwards the call to dev.CallMethod() then parses the payload of the reply as a RestoreSystemResponse.
+// Call_RestoreSystem forwards the call to sdk.Call() then parses the payload of the reply as a RestoreSystemResponse.
 func Call_RestoreSystem(ctx context.Context, dev *onvif.Device, request device.RestoreSystem) (device.RestoreSystemResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RestoreSystemResponse device.RestoreSystemResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RestoreSystemResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RestoreSystem")
-		return reply.Body.RestoreSystemResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.RestoreSystem, device.RestoreSystemResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_ScanAvailableDot11Networks forwards the call to dev.CallMethod() then parses the payload of the reply as a ScanAvailableDot11NetworksResponse.
+// Call_ScanAvailableDot11Networks forwards the call to sdk.Call() then parses the payload of the reply as a ScanAvailableDot11NetworksResponse.
 func Call_ScanAvailableDot11Networks(ctx context.Context, dev *onvif.Device, request device.ScanAvailableDot11Networks) (device.ScanAvailableDot11NetworksResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			ScanAvailableDot11NetworksResponse device.ScanAvailableDot11NetworksResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.ScanAvailableDot11NetworksResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "ScanAvailableDot11Networks")
-		return reply.Body.ScanAvailableDot11NetworksResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.ScanAvailableDot11Networks, device.ScanAvailableDot11NetworksResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetCertificatesStatus forwards the call to dev.CallMethod() then parses the payload of the reply as a SetCertificatesStatusResponse.
+// Call_SetCertificatesStatus forwards the call to sdk.Call() then parses the payload of the reply as a SetCertificatesStatusResponse.
 func Call_SetCertificatesStatus(ctx context.Context, dev *onvif.Device, request device.SetCertificatesStatus) (device.SetCertificatesStatusResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetCertificatesStatusResponse device.SetCertificatesStatusResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetCertificatesStatusResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetCertificatesStatus")
-		return reply.Body.SetCertificatesStatusResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetCertificatesStatus, device.SetCertificatesStatusResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetClientCertificateMode forwards the call to dev.CallMethod() then parses the payload of the reply as a SetClientCertificateModeResponse.
+// Call_SetClientCertificateMode forwards the call to sdk.Call() then parses the payload of the reply as a SetClientCertificateModeResponse.
 func Call_SetClientCertificateMode(ctx context.Context, dev *onvif.Device, request device.SetClientCertificateMode) (device.SetClientCertificateModeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetClientCertificateModeResponse device.SetClientCertificateModeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetClientCertificateModeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetClientCertificateMode")
-		return reply.Body.SetClientCertificateModeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetClientCertificateMode, device.SetClientCertificateModeResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetDNS forwards the call to dev.CallMethod() then parses the payload of the reply as a SetDNSResponse.
+// Call_SetDNS forwards the call to sdk.Call() then parses the payload of the reply as a SetDNSResponse.
 func Call_SetDNS(ctx context.Context, dev *onvif.Device, request device.SetDNS) (device.SetDNSResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetDNSResponse device.SetDNSResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetDNSResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetDNS")
-		return reply.Body.SetDNSResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetDNS, device.SetDNSResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetHostnameFromDHCP forwards the call to dev.CallMethod() then parses the payload of the reply as a SetHostnameFromDHCPResponse.
+// Call_SetHostnameFromDHCP forwards the call to sdk.Call() then parses the payload of the reply as a SetHostnameFromDHCPResponse.
 func Call_SetHostnameFromDHCP(ctx context.Context, dev *onvif.Device, request device.SetHostnameFromDHCP) (device.SetHostnameFromDHCPResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetHostnameFromDHCPResponse device.SetHostnameFromDHCPResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetHostnameFromDHCPResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetHostnameFromDHCP")
-		return reply.Body.SetHostnameFromDHCPResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetHostnameFromDHCP, device.SetHostnameFromDHCPResponse](ctx, dev, request)
 }
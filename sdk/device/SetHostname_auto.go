@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetHostname forwards the call to dev.CallMethod() then parses the payload of the reply as a SetHostnameResponse.
+// Call_SetHostname forwards the call to sdk.Call() then parses the payload of the reply as a SetHostnameResponse.
 func Call_SetHostname(ctx context.Context, dev *onvif.Device, request device.SetHostname) (device.SetHostnameResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetHostnameResponse device.SetHostnameResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetHostnameResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetHostname")
-		return reply.Body.SetHostnameResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetHostname, device.SetHostnameResponse](ctx, dev, request)
 }
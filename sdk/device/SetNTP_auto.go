@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetNTP forwards the call to dev.CallMethod() then parses the payload of the reply as a SetNTPResponse.
+// Call_SetNTP forwards the call to sdk.Call() then parses the payload of the reply as a SetNTPResponse.
 func Call_SetNTP(ctx context.Context, dev *onvif.Device, request device.SetNTP) (device.SetNTPResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetNTPResponse device.SetNTPResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetNTPResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetNTP")
-		return reply.Body.SetNTPResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetNTP, device.SetNTPResponse](ctx, dev, request)
 }
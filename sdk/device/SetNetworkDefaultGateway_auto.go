@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetNetworkDefaultGateway forwards the call to dev.CallMethod() then parses the payload of the reply as a SetNetworkDefaultGatewayResponse.
+// Call_SetNetworkDefaultGateway forwards the call to sdk.Call() then parses the payload of the reply as a SetNetworkDefaultGatewayResponse.
 func Call_SetNetworkDefaultGateway(ctx context.Context, dev *onvif.Device, request device.SetNetworkDefaultGateway) (device.SetNetworkDefaultGatewayResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetNetworkDefaultGatewayResponse device.SetNetworkDefaultGatewayResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetNetworkDefaultGatewayResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetNetworkDefaultGateway")
-		return reply.Body.SetNetworkDefaultGatewayResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetNetworkDefaultGateway, device.SetNetworkDefaultGatewayResponse](ctx, dev, request)
 }
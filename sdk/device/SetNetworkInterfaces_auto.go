@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetNetworkInterfaces forwards the call to dev.CallMethod() then parses the payload of the reply as a SetNetworkInterfacesResponse.
+// Call_SetNetworkInterfaces forwards the call to sdk.Call() then parses the payload of the reply as a SetNetworkInterfacesResponse.
 func Call_SetNetworkInterfaces(ctx context.Context, dev *onvif.Device, request device.SetNetworkInterfaces) (device.SetNetworkInterfacesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetNetworkInterfacesResponse device.SetNetworkInterfacesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetNetworkInterfacesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetNetworkInterfaces")
-		return reply.Body.SetNetworkInterfacesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetNetworkInterfaces, device.SetNetworkInterfacesResponse](ctx, dev, request)
 }
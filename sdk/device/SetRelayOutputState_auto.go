@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetRelayOutputState forwards the call to dev.CallMethod() then parses the payload of the reply as a SetRelayOutputStateResponse.
+// Call_SetRelayOutputState forwards the call to sdk.Call() then parses the payload of the reply as a SetRelayOutputStateResponse.
 func Call_SetRelayOutputState(ctx context.Context, dev *onvif.Device, request device.SetRelayOutputState) (device.SetRelayOutputStateResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetRelayOutputStateResponse device.SetRelayOutputStateResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetRelayOutputStateResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetRelayOutputState")
-		return reply.Body.SetRelayOutputStateResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetRelayOutputState, device.SetRelayOutputStateResponse](ctx, dev, request)
 }
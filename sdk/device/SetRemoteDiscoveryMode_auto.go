@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetRemoteDiscoveryMode forwards the call to dev.CallMethod() then parses the payload of the reply as a SetRemoteDiscoveryModeResponse.
+// Call_SetRemoteDiscoveryMode forwards the call to sdk.Call() then parses the payload of the reply as a SetRemoteDiscoveryModeResponse.
 func Call_SetRemoteDiscoveryMode(ctx context.Context, dev *onvif.Device, request device.SetRemoteDiscoveryMode) (device.SetRemoteDiscoveryModeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetRemoteDiscoveryModeResponse device.SetRemoteDiscoveryModeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetRemoteDiscoveryModeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetRemoteDiscoveryMode")
-		return reply.Body.SetRemoteDiscoveryModeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetRemoteDiscoveryMode, device.SetRemoteDiscoveryModeResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetRemoteUser forwards the call to dev.CallMethod() then parses the payload of the reply as a SetRemoteUserResponse.
+// Call_SetRemoteUser forwards the call to sdk.Call() then parses the payload of the reply as a SetRemoteUserResponse.
 func Call_SetRemoteUser(ctx context.Context, dev *onvif.Device, request device.SetRemoteUser) (device.SetRemoteUserResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetRemoteUserResponse device.SetRemoteUserResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetRemoteUserResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetRemoteUser")
-		return reply.Body.SetRemoteUserResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetRemoteUser, device.SetRemoteUserResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetScopes forwards the call to dev.CallMethod() then parses the payload of the reply as a SetScopesResponse.
+// Call_SetScopes forwards the call to sdk.Call() then parses the payload of the reply as a SetScopesResponse.
 func Call_SetScopes(ctx context.Context, dev *onvif.Device, request device.SetScopes) (device.SetScopesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetScopesResponse device.SetScopesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetScopesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetScopes")
-		return reply.Body.SetScopesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetScopes, device.SetScopesResponse](ctx, dev, request)
 }
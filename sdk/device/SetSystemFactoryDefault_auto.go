@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetSystemFactoryDefault forwards the call to dev.CallMethod() then parses the payload of the reply as a SetSystemFactoryDefaultResponse.
+// Call_SetSystemFactoryDefault forwards the call to sdk.Call() then parses the payload of the reply as a SetSystemFactoryDefaultResponse.
 func Call_SetSystemFactoryDefault(ctx context.Context, dev *onvif.Device, request device.SetSystemFactoryDefault) (device.SetSystemFactoryDefaultResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetSystemFactoryDefaultResponse device.SetSystemFactoryDefaultResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetSystemFactoryDefaultResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetSystemFactoryDefault")
-		return reply.Body.SetSystemFactoryDefaultResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetSystemFactoryDefault, device.SetSystemFactoryDefaultResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetUser forwards the call to dev.CallMethod() then parses the payload of the reply as a SetUserResponse.
+// Call_SetUser forwards the call to sdk.Call() then parses the payload of the reply as a SetUserResponse.
 func Call_SetUser(ctx context.Context, dev *onvif.Device, request device.SetUser) (device.SetUserResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetUserResponse device.SetUserResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetUserResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetUser")
-		return reply.Body.SetUserResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetUser, device.SetUserResponse](ctx, dev, request)
 }
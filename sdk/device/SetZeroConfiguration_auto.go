@@ -6,25 +6,12 @@ package device
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/device"
 )
 
-// Call_SetZeroConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a SetZeroConfigurationResponse.
+// Call_SetZeroConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a SetZeroConfigurationResponse.
 func Call_SetZeroConfiguration(ctx context.Context, dev *onvif.Device, request device.SetZeroConfiguration) (device.SetZeroConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetZeroConfigurationResponse device.SetZeroConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetZeroConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetZeroConfiguration")
-		return reply.Body.SetZeroConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[device.SetZeroConfiguration, device.SetZeroConfigurationResponse](ctx, dev, request)
 }
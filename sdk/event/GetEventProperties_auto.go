@@ -6,25 +6,12 @@ package event
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/event"
 )
 
-// Call_GetEventProperties forwards the call to dev.CallMethod() then parses the payload of the reply as a GetEventPropertiesResponse.
+// Call_GetEventProperties forwards the call to sdk.Call() then parses the payload of the reply as a GetEventPropertiesResponse.
 func Call_GetEventProperties(ctx context.Context, dev *onvif.Device, request event.GetEventProperties) (event.GetEventPropertiesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetEventPropertiesResponse event.GetEventPropertiesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetEventPropertiesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetEventProperties")
-		return reply.Body.GetEventPropertiesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[event.GetEventProperties, event.GetEventPropertiesResponse](ctx, dev, request)
 }
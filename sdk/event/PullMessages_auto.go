@@ -6,25 +6,12 @@ package event
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/event"
 )
 
-// Call_PullMessages forwards the call to dev.CallMethod() then parses the payload of the reply as a PullMessagesResponse.
+// Call_PullMessages forwards the call to sdk.Call() then parses the payload of the reply as a PullMessagesResponse.
 func Call_PullMessages(ctx context.Context, dev *onvif.Device, request event.PullMessages) (event.PullMessagesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			PullMessagesResponse event.PullMessagesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.PullMessagesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "PullMessages")
-		return reply.Body.PullMessagesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[event.PullMessages, event.PullMessagesResponse](ctx, dev, request)
 }
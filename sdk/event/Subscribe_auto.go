@@ -6,25 +6,12 @@ package event
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/event"
 )
 
-// Call_Subscribe forwards the call to dev.CallMethod() then parses the payload of the reply as a SubscribeResponse.
+// Call_Subscribe forwards the call to sdk.Call() then parses the payload of the reply as a SubscribeResponse.
 func Call_Subscribe(ctx context.Context, dev *onvif.Device, request event.Subscribe) (event.SubscribeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SubscribeResponse event.SubscribeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SubscribeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "Subscribe")
-		return reply.Body.SubscribeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[event.Subscribe, event.SubscribeResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package event
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/event"
 )
 
-// Call_Unsubscribe forwards the call to dev.CallMethod() then parses the payload of the reply as a UnsubscribeResponse.
+// Call_Unsubscribe forwards the call to sdk.Call() then parses the payload of the reply as a UnsubscribeResponse.
 func Call_Unsubscribe(ctx context.Context, dev *onvif.Device, request event.Unsubscribe) (event.UnsubscribeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			UnsubscribeResponse event.UnsubscribeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.UnsubscribeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "Unsubscribe")
-		return reply.Body.UnsubscribeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[event.Unsubscribe, event.UnsubscribeResponse](ctx, dev, request)
 }
@@ -0,0 +1,28 @@
+package event
+
+import (
+	"context"
+
+	"github.com/BalkarSandhu/go-onvif/event"
+	"github.com/BalkarSandhu/go-onvif/onvif"
+	"github.com/BalkarSandhu/go-onvif/sdk"
+)
+
+// The operations of a subscription are sent to its SubscriptionReference,
+// as returned by Subscribe or CreatePullPointSubscription, and not to the
+// event service of the device.
+
+// Call_PullMessagesTo sends a PullMessages to the pull point subscription ref then parses the payload of the reply as a PullMessagesResponse.
+func Call_PullMessagesTo(ctx context.Context, dev *onvif.Device, ref event.EndpointReferenceType, request event.PullMessages) (event.PullMessagesResponse, error) {
+	return sdk.CallEndpoint[event.PullMessages, event.PullMessagesResponse](ctx, dev, ref, request)
+}
+
+// Call_RenewTo sends a Renew to the subscription ref then parses the payload of the reply as a RenewResponse.
+func Call_RenewTo(ctx context.Context, dev *onvif.Device, ref event.EndpointReferenceType, request event.Renew) (event.RenewResponse, error) {
+	return sdk.CallEndpoint[event.Renew, event.RenewResponse](ctx, dev, ref, request)
+}
+
+// Call_UnsubscribeTo sends an Unsubscribe to the subscription ref then parses the payload of the reply as an UnsubscribeResponse.
+func Call_UnsubscribeTo(ctx context.Context, dev *onvif.Device, ref event.EndpointReferenceType, request event.Unsubscribe) (event.UnsubscribeResponse, error) {
+	return sdk.CallEndpoint[event.Unsubscribe, event.UnsubscribeResponse](ctx, dev, ref, request)
+}
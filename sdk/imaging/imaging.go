@@ -0,0 +1,3 @@
+package imaging
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../../docs/wsdl/imaging.wsdl imaging Imaging
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddAudioDecoderConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddAudioDecoderConfigurationResponse.
+// Call_AddAudioDecoderConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddAudioDecoderConfigurationResponse.
 func Call_AddAudioDecoderConfiguration(ctx context.Context, dev *onvif.Device, request media.AddAudioDecoderConfiguration) (media.AddAudioDecoderConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddAudioDecoderConfigurationResponse media.AddAudioDecoderConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddAudioDecoderConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddAudioDecoderConfiguration")
-		return reply.Body.AddAudioDecoderConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddAudioDecoderConfiguration, media.AddAudioDecoderConfigurationResponse](ctx, dev, request)
 }
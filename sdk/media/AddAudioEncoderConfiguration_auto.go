@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddAudioEncoderConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddAudioEncoderConfigurationResponse.
+// Call_AddAudioEncoderConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddAudioEncoderConfigurationResponse.
 func Call_AddAudioEncoderConfiguration(ctx context.Context, dev *onvif.Device, request media.AddAudioEncoderConfiguration) (media.AddAudioEncoderConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddAudioEncoderConfigurationResponse media.AddAudioEncoderConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddAudioEncoderConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddAudioEncoderConfiguration")
-		return reply.Body.AddAudioEncoderConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddAudioEncoderConfiguration, media.AddAudioEncoderConfigurationResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddAudioOutputConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddAudioOutputConfigurationResponse.
+// Call_AddAudioOutputConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddAudioOutputConfigurationResponse.
 func Call_AddAudioOutputConfiguration(ctx context.Context, dev *onvif.Device, request media.AddAudioOutputConfiguration) (media.AddAudioOutputConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddAudioOutputConfigurationResponse media.AddAudioOutputConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddAudioOutputConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddAudioOutputConfiguration")
-		return reply.Body.AddAudioOutputConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddAudioOutputConfiguration, media.AddAudioOutputConfigurationResponse](ctx, dev, request)
 }
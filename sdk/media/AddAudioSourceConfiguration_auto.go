@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddAudioSourceConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddAudioSourceConfigurationResponse.
+// Call_AddAudioSourceConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddAudioSourceConfigurationResponse.
 func Call_AddAudioSourceConfiguration(ctx context.Context, dev *onvif.Device, request media.AddAudioSourceConfiguration) (media.AddAudioSourceConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddAudioSourceConfigurationResponse media.AddAudioSourceConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddAudioSourceConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddAudioSourceConfiguration")
-		return reply.Body.AddAudioSourceConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddAudioSourceConfiguration, media.AddAudioSourceConfigurationResponse](ctx, dev, request)
 }
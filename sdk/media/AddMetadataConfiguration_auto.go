@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddMetadataConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddMetadataConfigurationResponse.
+// Call_AddMetadataConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddMetadataConfigurationResponse.
 func Call_AddMetadataConfiguration(ctx context.Context, dev *onvif.Device, request media.AddMetadataConfiguration) (media.AddMetadataConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddMetadataConfigurationResponse media.AddMetadataConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddMetadataConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddMetadataConfiguration")
-		return reply.Body.AddMetadataConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddMetadataConfiguration, media.AddMetadataConfigurationResponse](ctx, dev, request)
 }
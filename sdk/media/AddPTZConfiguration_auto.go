@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddPTZConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddPTZConfigurationResponse.
+// Call_AddPTZConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddPTZConfigurationResponse.
 func Call_AddPTZConfiguration(ctx context.Context, dev *onvif.Device, request media.AddPTZConfiguration) (media.AddPTZConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddPTZConfigurationResponse media.AddPTZConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddPTZConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddPTZConfiguration")
-		return reply.Body.AddPTZConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddPTZConfiguration, media.AddPTZConfigurationResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddVideoAnalyticsConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddVideoAnalyticsConfigurationResponse.
+// Call_AddVideoAnalyticsConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddVideoAnalyticsConfigurationResponse.
 func Call_AddVideoAnalyticsConfiguration(ctx context.Context, dev *onvif.Device, request media.AddVideoAnalyticsConfiguration) (media.AddVideoAnalyticsConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddVideoAnalyticsConfigurationResponse media.AddVideoAnalyticsConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddVideoAnalyticsConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddVideoAnalyticsConfiguration")
-		return reply.Body.AddVideoAnalyticsConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddVideoAnalyticsConfiguration, media.AddVideoAnalyticsConfigurationResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_AddVideoSourceConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a AddVideoSourceConfigurationResponse.
+// Call_AddVideoSourceConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a AddVideoSourceConfigurationResponse.
 func Call_AddVideoSourceConfiguration(ctx context.Context, dev *onvif.Device, request media.AddVideoSourceConfiguration) (media.AddVideoSourceConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AddVideoSourceConfigurationResponse media.AddVideoSourceConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AddVideoSourceConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AddVideoSourceConfiguration")
-		return reply.Body.AddVideoSourceConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.AddVideoSourceConfiguration, media.AddVideoSourceConfigurationResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_CreateOSD forwards the call to dev.CallMethod() then parses the payload of the reply as a CreateOSDResponse.
+// Call_CreateOSD forwards the call to sdk.Call() then parses the payload of the reply as a CreateOSDResponse.
 func Call_CreateOSD(ctx context.Context, dev *onvif.Device, request media.CreateOSD) (media.CreateOSDResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			CreateOSDResponse media.CreateOSDResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.CreateOSDResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "CreateOSD")
-		return reply.Body.CreateOSDResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.CreateOSD, media.CreateOSDResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_CreateProfile forwards the call to dev.CallMethod() then parses the payload of the reply as a CreateProfileResponse.
+// Call_CreateProfile forwards the call to sdk.Call() then parses the payload of the reply as a CreateProfileResponse.
 func Call_CreateProfile(ctx context.Context, dev *onvif.Device, request media.CreateProfile) (media.CreateProfileResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			CreateProfileResponse media.CreateProfileResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.CreateProfileResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "CreateProfile")
-		return reply.Body.CreateProfileResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.CreateProfile, media.CreateProfileResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_DeleteOSD forwards the call to dev.CallMethod() then parses the payload of the reply as a DeleteOSDResponse.
+// Call_DeleteOSD forwards the call to sdk.Call() then parses the payload of the reply as a DeleteOSDResponse.
 func Call_DeleteOSD(ctx context.Context, dev *onvif.Device, request media.DeleteOSD) (media.DeleteOSDResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			DeleteOSDResponse media.DeleteOSDResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.DeleteOSDResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "DeleteOSD")
-		return reply.Body.DeleteOSDResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.DeleteOSD, media.DeleteOSDResponse](ctx, dev, request)
 }
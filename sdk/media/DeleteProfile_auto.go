@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_DeleteProfile forwards the call to dev.CallMethod() then parses the payload of the reply as a DeleteProfileResponse.
+// Call_DeleteProfile forwards the call to sdk.Call() then parses the payload of the reply as a DeleteProfileResponse.
 func Call_DeleteProfile(ctx context.Context, dev *onvif.Device, request media.DeleteProfile) (media.DeleteProfileResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			DeleteProfileResponse media.DeleteProfileResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.DeleteProfileResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "DeleteProfile")
-		return reply.Body.DeleteProfileResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.DeleteProfile, media.DeleteProfileResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetAudioSourceConfigurationOptions forwards the call to dev.CallMethod() then parses the payload of the reply as a GetAudioSourceConfigurationOptionsResponse.
+// Call_GetAudioSourceConfigurationOptions forwards the call to sdk.Call() then parses the payload of the reply as a GetAudioSourceConfigurationOptionsResponse.
 func Call_GetAudioSourceConfigurationOptions(ctx context.Context, dev *onvif.Device, request media.GetAudioSourceConfigurationOptions) (media.GetAudioSourceConfigurationOptionsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetAudioSourceConfigurationOptionsResponse media.GetAudioSourceConfigurationOptionsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetAudioSourceConfigurationOptionsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetAudioSourceConfigurationOptions")
-		return reply.Body.GetAudioSourceConfigurationOptionsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetAudioSourceConfigurationOptions, media.GetAudioSourceConfigurationOptionsResponse](ctx, dev, request)
 }
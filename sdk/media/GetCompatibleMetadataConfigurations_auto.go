@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetCompatibleMetadataConfigurations forwards the call to dev.CallMethod() then parses the payload of the reply as a GetCompatibleMetadataConfigurationsResponse.
+// Call_GetCompatibleMetadataConfigurations forwards the call to sdk.Call() then parses the payload of the reply as a GetCompatibleMetadataConfigurationsResponse.
 func Call_GetCompatibleMetadataConfigurations(ctx context.Context, dev *onvif.Device, request media.GetCompatibleMetadataConfigurations) (media.GetCompatibleMetadataConfigurationsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetCompatibleMetadataConfigurationsResponse media.GetCompatibleMetadataConfigurationsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetCompatibleMetadataConfigurationsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetCompatibleMetadataConfigurations")
-		return reply.Body.GetCompatibleMetadataConfigurationsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetCompatibleMetadataConfigurations, media.GetCompatibleMetadataConfigurationsResponse](ctx, dev, request)
 }
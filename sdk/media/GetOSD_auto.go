@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetOSD forwards the call to dev.CallMethod() then parses the payload of the reply as a GetOSDResponse.
+// Call_GetOSD forwards the call to sdk.Call() then parses the payload of the reply as a GetOSDResponse.
 func Call_GetOSD(ctx context.Context, dev *onvif.Device, request media.GetOSD) (media.GetOSDResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetOSDResponse media.GetOSDResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetOSDResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetOSD")
-		return reply.Body.GetOSDResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetOSD, media.GetOSDResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetOSDs forwards the call to dev.CallMethod() then parses the payload of the reply as a GetOSDsResponse.
+// Call_GetOSDs forwards the call to sdk.Call() then parses the payload of the reply as a GetOSDsResponse.
 func Call_GetOSDs(ctx context.Context, dev *onvif.Device, request media.GetOSDs) (media.GetOSDsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetOSDsResponse media.GetOSDsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetOSDsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetOSDs")
-		return reply.Body.GetOSDsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetOSDs, media.GetOSDsResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetProfile forwards the call to dev.CallMethod() then parses the payload of the reply as a GetProfileResponse.
+// Call_GetProfile forwards the call to sdk.Call() then parses the payload of the reply as a GetProfileResponse.
 func Call_GetProfile(ctx context.Context, dev *onvif.Device, request media.GetProfile) (media.GetProfileResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetProfileResponse media.GetProfileResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetProfileResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetProfile")
-		return reply.Body.GetProfileResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetProfile, media.GetProfileResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetProfiles forwards the call to dev.CallMethod() then parses the payload of the reply as a GetProfilesResponse.
+// Call_GetProfiles forwards the call to sdk.Call() then parses the payload of the reply as a GetProfilesResponse.
 func Call_GetProfiles(ctx context.Context, dev *onvif.Device, request media.GetProfiles) (media.GetProfilesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetProfilesResponse media.GetProfilesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetProfilesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetProfiles")
-		return reply.Body.GetProfilesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetProfiles, media.GetProfilesResponse](ctx, dev, request)
 }
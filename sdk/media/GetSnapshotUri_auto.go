@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetSnapshotUri forwards the call to dev.CallMethod() then parses the payload of the reply as a GetSnapshotUriResponse.
+// Call_GetSnapshotUri forwards the call to sdk.Call() then parses the payload of the reply as a GetSnapshotUriResponse.
 func Call_GetSnapshotUri(ctx context.Context, dev *onvif.Device, request media.GetSnapshotUri) (media.GetSnapshotUriResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetSnapshotUriResponse media.GetSnapshotUriResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetSnapshotUriResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetSnapshotUri")
-		return reply.Body.GetSnapshotUriResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetSnapshotUri, media.GetSnapshotUriResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_GetStreamUri forwards the call to dev.CallMethod() then parses the payload of the reply as a GetStreamUriResponse.
+// Call_GetStreamUri forwards the call to sdk.Call() then parses the payload of the reply as a GetStreamUriResponse.
 func Call_GetStreamUri(ctx context.Context, dev *onvif.Device, request media.GetStreamUri) (media.GetStreamUriResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetStreamUriResponse media.GetStreamUriResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetStreamUriResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetStreamUri")
-		return reply.Body.GetStreamUriResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.GetStreamUri, media.GetStreamUriResponse](ctx, dev, request)
 }
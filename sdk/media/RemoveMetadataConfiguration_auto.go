@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_RemoveMetadataConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a RemoveMetadataConfigurationResponse.
+// Call_RemoveMetadataConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a RemoveMetadataConfigurationResponse.
 func Call_RemoveMetadataConfiguration(ctx context.Context, dev *onvif.Device, request media.RemoveMetadataConfiguration) (media.RemoveMetadataConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemoveMetadataConfigurationResponse media.RemoveMetadataConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemoveMetadataConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemoveMetadataConfiguration")
-		return reply.Body.RemoveMetadataConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.RemoveMetadataConfiguration, media.RemoveMetadataConfigurationResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_RemovePTZConfiguration forwards the call to dev.CallMethod() then parses the payload of the reply as a RemovePTZConfigurationResponse.
+// Call_RemovePTZConfiguration forwards the call to sdk.Call() then parses the payload of the reply as a RemovePTZConfigurationResponse.
 func Call_RemovePTZConfiguration(ctx context.Context, dev *onvif.Device, request media.RemovePTZConfiguration) (media.RemovePTZConfigurationResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemovePTZConfigurationResponse media.RemovePTZConfigurationResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemovePTZConfigurationResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemovePTZConfiguration")
-		return reply.Body.RemovePTZConfigurationResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.RemovePTZConfiguration, media.RemovePTZConfigurationResponse](ctx, dev, request)
 }
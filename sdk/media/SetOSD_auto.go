@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_SetOSD forwards the call to dev.CallMethod() then parses the payload of the reply as a SetOSDResponse.
+// Call_SetOSD forwards the call to sdk.Call() then parses the payload of the reply as a SetOSDResponse.
 func Call_SetOSD(ctx context.Context, dev *onvif.Device, request media.SetOSD) (media.SetOSDResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetOSDResponse media.SetOSDResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetOSDResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetOSD")
-		return reply.Body.SetOSDResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.SetOSD, media.SetOSDResponse](ctx, dev, request)
 }
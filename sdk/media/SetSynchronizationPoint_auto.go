@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_SetSynchronizationPoint forwards the call to dev.CallMethod() then parses the payload of the reply as a SetSynchronizationPointResponse.
+// Call_SetSynchronizationPoint forwards the call to sdk.Call() then parses the payload of the reply as a SetSynchronizationPointResponse.
 func Call_SetSynchronizationPoint(ctx context.Context, dev *onvif.Device, request media.SetSynchronizationPoint) (media.SetSynchronizationPointResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetSynchronizationPointResponse media.SetSynchronizationPointResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetSynchronizationPointResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetSynchronizationPoint")
-		return reply.Body.SetSynchronizationPointResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.SetSynchronizationPoint, media.SetSynchronizationPointResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_SetVideoSourceMode forwards the call to dev.CallMethod() then parses the payload of the reply as a SetVideoSourceModeResponse.
+// Call_SetVideoSourceMode forwards the call to sdk.Call() then parses the payload of the reply as a SetVideoSourceModeResponse.
 func Call_SetVideoSourceMode(ctx context.Context, dev *onvif.Device, request media.SetVideoSourceMode) (media.SetVideoSourceModeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetVideoSourceModeResponse media.SetVideoSourceModeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetVideoSourceModeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetVideoSourceMode")
-		return reply.Body.SetVideoSourceModeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.SetVideoSourceMode, media.SetVideoSourceModeResponse](ctx, dev, request)
 }
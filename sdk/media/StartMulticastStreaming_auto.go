@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_StartMulticastStreaming forwards the call to dev.CallMethod() then parses the payload of the reply as a StartMulticastStreamingResponse.
+// Call_StartMulticastStreaming forwards the call to sdk.Call() then parses the payload of the reply as a StartMulticastStreamingResponse.
 func Call_StartMulticastStreaming(ctx context.Context, dev *onvif.Device, request media.StartMulticastStreaming) (media.StartMulticastStreamingResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			StartMulticastStreamingResponse media.StartMulticastStreamingResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.StartMulticastStreamingResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "StartMulticastStreaming")
-		return reply.Body.StartMulticastStreamingResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.StartMulticastStreaming, media.StartMulticastStreamingResponse](ctx, dev, request)
 }
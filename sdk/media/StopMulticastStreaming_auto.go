@@ -6,25 +6,12 @@ package media
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/media"
 )
 
-// Call_StopMulticastStreaming forwards the call to dev.CallMethod() then parses the payload of the reply as a StopMulticastStreamingResponse.
+// Call_StopMulticastStreaming forwards the call to sdk.Call() then parses the payload of the reply as a StopMulticastStreamingResponse.
 func Call_StopMulticastStreaming(ctx context.Context, dev *onvif.Device, request media.StopMulticastStreaming) (media.StopMulticastStreamingResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			StopMulticastStreamingResponse media.StopMulticastStreamingResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.StopMulticastStreamingResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "StopMulticastStreaming")
-		return reply.Body.StopMulticastStreamingResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[media.StopMulticastStreaming, media.StopMulticastStreamingResponse](ctx, dev, request)
 }
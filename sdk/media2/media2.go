@@ -0,0 +1,3 @@
+package media2
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../../docs/wsdl/media2.wsdl media2 media2
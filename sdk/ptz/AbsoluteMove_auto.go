@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_AbsoluteMove forwards the call to dev.CallMethod() then parses the payload of the reply as a AbsoluteMoveResponse.
+// Call_AbsoluteMove forwards the call to sdk.Call() then parses the payload of the reply as a AbsoluteMoveResponse.
 func Call_AbsoluteMove(ctx context.Context, dev *onvif.Device, request ptz.AbsoluteMove) (ptz.AbsoluteMoveResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			AbsoluteMoveResponse ptz.AbsoluteMoveResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.AbsoluteMoveResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "AbsoluteMove")
-		return reply.Body.AbsoluteMoveResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.AbsoluteMove, ptz.AbsoluteMoveResponse](ctx, dev, request)
 }
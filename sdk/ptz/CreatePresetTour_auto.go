@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_CreatePresetTour forwards the call to dev.CallMethod() then parses the payload of the reply as a CreatePresetTourResponse.
+// Call_CreatePresetTour forwards the call to sdk.Call() then parses the payload of the reply as a CreatePresetTourResponse.
 func Call_CreatePresetTour(ctx context.Context, dev *onvif.Device, request ptz.CreatePresetTour) (ptz.CreatePresetTourResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			CreatePresetTourResponse ptz.CreatePresetTourResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.CreatePresetTourResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "CreatePresetTour")
-		return reply.Body.CreatePresetTourResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.CreatePresetTour, ptz.CreatePresetTourResponse](ctx, dev, request)
 }
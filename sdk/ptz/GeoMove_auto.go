@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GeoMove forwards the call to dev.CallMethod() then parses the payload of the reply as a GeoMoveResponse.
+// Call_GeoMove forwards the call to sdk.Call() then parses the payload of the reply as a GeoMoveResponse.
 func Call_GeoMove(ctx context.Context, dev *onvif.Device, request ptz.GeoMove) (ptz.GeoMoveResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GeoMoveResponse ptz.GeoMoveResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GeoMoveResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GeoMove")
-		return reply.Body.GeoMoveResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GeoMove, ptz.GeoMoveResponse](ctx, dev, request)
 }
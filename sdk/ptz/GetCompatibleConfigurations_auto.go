@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetCompatibleConfigurations forwards the call to dev.CallMethod() then parses the payload of the reply as a GetCompatibleConfigurationsResponse.
+// Call_GetCompatibleConfigurations forwards the call to sdk.Call() then parses the payload of the reply as a GetCompatibleConfigurationsResponse.
 func Call_GetCompatibleConfigurations(ctx context.Context, dev *onvif.Device, request ptz.GetCompatibleConfigurations) (ptz.GetCompatibleConfigurationsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetCompatibleConfigurationsResponse ptz.GetCompatibleConfigurationsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetCompatibleConfigurationsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetCompatibleConfigurations")
-		return reply.Body.GetCompatibleConfigurationsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetCompatibleConfigurations, ptz.GetCompatibleConfigurationsResponse](ctx, dev, request)
 }
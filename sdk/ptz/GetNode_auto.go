@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetNode forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNodeResponse.
+// Call_GetNode forwards the call to sdk.Call() then parses the payload of the reply as a GetNodeResponse.
 func Call_GetNode(ctx context.Context, dev *onvif.Device, request ptz.GetNode) (ptz.GetNodeResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNodeResponse ptz.GetNodeResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNodeResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNode")
-		return reply.Body.GetNodeResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetNode, ptz.GetNodeResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetNodes forwards the call to dev.CallMethod() then parses the payload of the reply as a GetNodesResponse.
+// Call_GetNodes forwards the call to sdk.Call() then parses the payload of the reply as a GetNodesResponse.
 func Call_GetNodes(ctx context.Context, dev *onvif.Device, request ptz.GetNodes) (ptz.GetNodesResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetNodesResponse ptz.GetNodesResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetNodesResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetNodes")
-		return reply.Body.GetNodesResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetNodes, ptz.GetNodesResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetPresetTourOptions forwards the call to dev.CallMethod() then parses the payload of the reply as a GetPresetTourOptionsResponse.
+// Call_GetPresetTourOptions forwards the call to sdk.Call() then parses the payload of the reply as a GetPresetTourOptionsResponse.
 func Call_GetPresetTourOptions(ctx context.Context, dev *onvif.Device, request ptz.GetPresetTourOptions) (ptz.GetPresetTourOptionsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetPresetTourOptionsResponse ptz.GetPresetTourOptionsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetPresetTourOptionsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetPresetTourOptions")
-		return reply.Body.GetPresetTourOptionsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetPresetTourOptions, ptz.GetPresetTourOptionsResponse](ctx, dev, request)
 }
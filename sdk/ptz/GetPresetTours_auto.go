@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetPresetTours forwards the call to dev.CallMethod() then parses the payload of the reply as a GetPresetToursResponse.
+// Call_GetPresetTours forwards the call to sdk.Call() then parses the payload of the reply as a GetPresetToursResponse.
 func Call_GetPresetTours(ctx context.Context, dev *onvif.Device, request ptz.GetPresetTours) (ptz.GetPresetToursResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetPresetToursResponse ptz.GetPresetToursResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetPresetToursResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetPresetTours")
-		return reply.Body.GetPresetToursResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetPresetTours, ptz.GetPresetToursResponse](ctx, dev, request)
 }
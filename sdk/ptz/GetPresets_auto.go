@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetPresets forwards the call to dev.CallMethod() then parses the payload of the reply as a GetPresetsResponse.
+// Call_GetPresets forwards the call to sdk.Call() then parses the payload of the reply as a GetPresetsResponse.
 func Call_GetPresets(ctx context.Context, dev *onvif.Device, request ptz.GetPresets) (ptz.GetPresetsResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetPresetsResponse ptz.GetPresetsResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetPresetsResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetPresets")
-		return reply.Body.GetPresetsResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetPresets, ptz.GetPresetsResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GetStatus forwards the call to dev.CallMethod() then parses the payload of the reply as a GetStatusResponse.
+// Call_GetStatus forwards the call to sdk.Call() then parses the payload of the reply as a GetStatusResponse.
 func Call_GetStatus(ctx context.Context, dev *onvif.Device, request ptz.GetStatus) (ptz.GetStatusResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GetStatusResponse ptz.GetStatusResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GetStatusResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GetStatus")
-		return reply.Body.GetStatusResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GetStatus, ptz.GetStatusResponse](ctx, dev, request)
 }
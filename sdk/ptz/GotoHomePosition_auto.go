@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GotoHomePosition forwards the call to dev.CallMethod() then parses the payload of the reply as a GotoHomePositionResponse.
+// Call_GotoHomePosition forwards the call to sdk.Call() then parses the payload of the reply as a GotoHomePositionResponse.
 func Call_GotoHomePosition(ctx context.Context, dev *onvif.Device, request ptz.GotoHomePosition) (ptz.GotoHomePositionResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GotoHomePositionResponse ptz.GotoHomePositionResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GotoHomePositionResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GotoHomePosition")
-		return reply.Body.GotoHomePositionResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GotoHomePosition, ptz.GotoHomePositionResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_GotoPreset forwards the call to dev.CallMethod() then parses the payload of the reply as a GotoPresetResponse.
+// Call_GotoPreset forwards the call to sdk.Call() then parses the payload of the reply as a GotoPresetResponse.
 func Call_GotoPreset(ctx context.Context, dev *onvif.Device, request ptz.GotoPreset) (ptz.GotoPresetResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			GotoPresetResponse ptz.GotoPresetResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.GotoPresetResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "GotoPreset")
-		return reply.Body.GotoPresetResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.GotoPreset, ptz.GotoPresetResponse](ctx, dev, request)
 }
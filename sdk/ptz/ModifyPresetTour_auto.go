@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_ModifyPresetTour forwards the call to dev.CallMethod() then parses the payload of the reply as a ModifyPresetTourResponse.
+// Call_ModifyPresetTour forwards the call to sdk.Call() then parses the payload of the reply as a ModifyPresetTourResponse.
 func Call_ModifyPresetTour(ctx context.Context, dev *onvif.Device, request ptz.ModifyPresetTour) (ptz.ModifyPresetTourResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			ModifyPresetTourResponse ptz.ModifyPresetTourResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.ModifyPresetTourResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "ModifyPresetTour")
-		return reply.Body.ModifyPresetTourResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.ModifyPresetTour, ptz.ModifyPresetTourResponse](ctx, dev, request)
 }
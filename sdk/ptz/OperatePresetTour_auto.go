@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_OperatePresetTour forwards the call to dev.CallMethod() then parses the payload of the reply as a OperatePresetTourResponse.
+// Call_OperatePresetTour forwards the call to sdk.Call() then parses the payload of the reply as a OperatePresetTourResponse.
 func Call_OperatePresetTour(ctx context.Context, dev *onvif.Device, request ptz.OperatePresetTour) (ptz.OperatePresetTourResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			OperatePresetTourResponse ptz.OperatePresetTourResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.OperatePresetTourResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "OperatePresetTour")
-		return reply.Body.OperatePresetTourResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.OperatePresetTour, ptz.OperatePresetTourResponse](ctx, dev, request)
 }
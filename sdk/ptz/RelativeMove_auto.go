@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_RelativeMove forwards the call to dev.CallMethod() then parses the payload of the reply as a RelativeMoveResponse.
+// Call_RelativeMove forwards the call to sdk.Call() then parses the payload of the reply as a RelativeMoveResponse.
 func Call_RelativeMove(ctx context.Context, dev *onvif.Device, request ptz.RelativeMove) (ptz.RelativeMoveResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RelativeMoveResponse ptz.RelativeMoveResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RelativeMoveResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RelativeMove")
-		return reply.Body.RelativeMoveResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.RelativeMove, ptz.RelativeMoveResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_RemovePresetTour forwards the call to dev.CallMethod() then parses the payload of the reply as a RemovePresetTourResponse.
+// Call_RemovePresetTour forwards the call to sdk.Call() then parses the payload of the reply as a RemovePresetTourResponse.
 func Call_RemovePresetTour(ctx context.Context, dev *onvif.Device, request ptz.RemovePresetTour) (ptz.RemovePresetTourResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemovePresetTourResponse ptz.RemovePresetTourResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemovePresetTourResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemovePresetTour")
-		return reply.Body.RemovePresetTourResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.RemovePresetTour, ptz.RemovePresetTourResponse](ctx, dev, request)
 }
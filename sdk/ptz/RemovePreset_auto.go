@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_RemovePreset forwards the call to dev.CallMethod() then parses the payload of the reply as a RemovePresetResponse.
+// Call_RemovePreset forwards the call to sdk.Call() then parses the payload of the reply as a RemovePresetResponse.
 func Call_RemovePreset(ctx context.Context, dev *onvif.Device, request ptz.RemovePreset) (ptz.RemovePresetResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			RemovePresetResponse ptz.RemovePresetResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.RemovePresetResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "RemovePreset")
-		return reply.Body.RemovePresetResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.RemovePreset, ptz.RemovePresetResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_SendAuxiliaryCommand forwards the call to dev.CallMethod() then parses the payload of the reply as a SendAuxiliaryCommandResponse.
+// Call_SendAuxiliaryCommand forwards the call to sdk.Call() then parses the payload of the reply as a SendAuxiliaryCommandResponse.
 func Call_SendAuxiliaryCommand(ctx context.Context, dev *onvif.Device, request ptz.SendAuxiliaryCommand) (ptz.SendAuxiliaryCommandResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SendAuxiliaryCommandResponse ptz.SendAuxiliaryCommandResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SendAuxiliaryCommandResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SendAuxiliaryCommand")
-		return reply.Body.SendAuxiliaryCommandResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.SendAuxiliaryCommand, ptz.SendAuxiliaryCommandResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_SetHomePosition forwards the call to dev.CallMethod() then parses the payload of the reply as a SetHomePositionResponse.
+// Call_SetHomePosition forwards the call to sdk.Call() then parses the payload of the reply as a SetHomePositionResponse.
 func Call_SetHomePosition(ctx context.Context, dev *onvif.Device, request ptz.SetHomePosition) (ptz.SetHomePositionResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetHomePositionResponse ptz.SetHomePositionResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetHomePositionResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetHomePosition")
-		return reply.Body.SetHomePositionResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.SetHomePosition, ptz.SetHomePositionResponse](ctx, dev, request)
 }
@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_SetPreset forwards the call to dev.CallMethod() then parses the payload of the reply as a SetPresetResponse.
+// Call_SetPreset forwards the call to sdk.Call() then parses the payload of the reply as a SetPresetResponse.
 func Call_SetPreset(ctx context.Context, dev *onvif.Device, request ptz.SetPreset) (ptz.SetPresetResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			SetPresetResponse ptz.SetPresetResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.SetPresetResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "SetPreset")
-		return reply.Body.SetPresetResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.SetPreset, ptz.SetPresetResponse](ctx, dev, request)
 }
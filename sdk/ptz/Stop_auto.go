@@ -6,25 +6,12 @@ package ptz
 
 import (
 	"context"
-	"github.com/juju/errors"
 	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/BalkarSandhu/go-onvif/sdk"
 	"github.com/BalkarSandhu/go-onvif/ptz"
 )
 
-// Call_Stop forwards the call to dev.CallMethod() then parses the payload of the reply as a StopResponse.
+// Call_Stop forwards the call to sdk.Call() then parses the payload of the reply as a StopResponse.
 func Call_Stop(ctx context.Context, dev *onvif.Device, request ptz.Stop) (ptz.StopResponse, error) {
-	type Envelope struct {
-		Header struct{}
-		Body   struct {
-			StopResponse ptz.StopResponse
-		}
-	}
-	var reply Envelope
-	if httpReply, err := dev.CallMethod(request); err != nil {
-		return reply.Body.StopResponse, errors.Annotate(err, "call")
-	} else {
-		err = sdk.ReadAndParse(ctx, httpReply, &reply, "Stop")
-		return reply.Body.StopResponse, errors.Annotate(err, "reply")
-	}
+	return sdk.Call[ptz.Stop, ptz.StopResponse](ctx, dev, request)
 }
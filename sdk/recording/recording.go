@@ -0,0 +1,3 @@
+package recording
+
+//go:generate go run github.com/BalkarSandhu/go-onvif/sdk/codegen -wsdl ../../docs/wsdl/recording.wsdl recording recording
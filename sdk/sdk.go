@@ -1,13 +1,18 @@
 package sdk
 
 import (
+	"bytes"
 	"context"
 	"encoding/xml"
-	"io/ioutil"
+	"io"
 	"net/http"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/onvif"
 	"github.com/juju/errors"
 	"github.com/rs/zerolog"
 )
@@ -24,20 +29,173 @@ var (
 	Logger = LoggerContext.Logger()
 )
 
+// ReadAndParse reads the body of httpReply and decodes it into reply.
+// The reading is aborted when ctx is done, the error of ctx is then returned.
+// A reply holding a SOAP Fault is reported as a *gosoap.SOAPFault.
+// The attachments of an MTOM reply are streamed by the Content of the
+// onvif.AttachmentData of reply, the body stays open until they are read.
+// No more than gosoap.MaxMTOMBufferSize bytes of the reply are held in memory.
 func ReadAndParse(ctx context.Context, httpReply *http.Response, reply interface{}, tag string) error {
+	return readReply(ctx, httpReply, tag, reply, replyFields(reply), gosoap.MaxMTOMBufferSize)
+}
+
+// readReply decodes the elements of the SOAP Body of httpReply into the
+// values element returns for their local names, or the whole envelope into
+// reply when element is nil. The attachments are bound to reply, holding no
+// more than limit bytes of an MTOM reply in memory.
+func readReply(ctx context.Context, httpReply *http.Response, tag string, reply interface{}, element func(local string) interface{}, limit int64) error {
 	Logger.Debug().
 		Str("msg", httpReply.Status).
 		Int("status", httpReply.StatusCode).
 		Str("action", tag).
 		Msg("RPC")
-	// TODO(jfsmig): extract the deadline from ctx.Deadline() and apply it on the reply reading
-	b, err := ioutil.ReadAll(httpReply.Body)
-	if err != nil {
-		return errors.Annotate(err, "read")
+
+	// The body is expected to be bound to ctx (see onvif.Device.CallMethodContext),
+	// closing it when ctx is done also covers bodies that are not.
+	stop := context.AfterFunc(ctx, func() { httpReply.Body.Close() })
+
+	if gosoap.IsMTOM(httpReply.Header.Get("Content-Type")) {
+		return readMTOM(ctx, httpReply, reply, element, stop, limit)
 	}
+	defer stop()
 
-	httpReply.Body.Close()
+	defer httpReply.Body.Close()
 
-	err = xml.Unmarshal(b, reply)
+	if err := decodeEnvelope(xml.NewDecoder(httpReply.Body), httpReply.StatusCode, reply, element); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return err
+	}
+	return nil
+}
+
+// decodeEnvelope decodes the elements of the SOAP Body read by d into the
+// values element returns for them, as xml.Unmarshal would, without holding
+// the whole reply in memory. A Fault is returned as a *gosoap.SOAPFault.
+func decodeEnvelope(d *xml.Decoder, statusCode int, reply interface{}, element func(local string) interface{}) error {
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return gosoap.FaultFromReply(statusCode, nil)
+		}
+		if err != nil {
+			return decodeError(err, statusCode)
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+
+		switch start.Name.Local {
+		case "Envelope":
+			if element == nil {
+				// Not a generated envelope, decode it as a whole
+				if err = d.DecodeElement(reply, &start); err != nil {
+					return decodeError(err, statusCode)
+				}
+				return gosoap.FaultFromReply(statusCode, nil)
+			}
+		case "Body":
+			// The elements of the Body follow
+		case "Fault":
+			fault, err := gosoap.DecodeFault(d, &start)
+			if err != nil {
+				return decodeError(err, statusCode)
+			}
+			fault.StatusCode = statusCode
+			return fault
+		default:
+			if v := element(start.Name.Local); v != nil {
+				err = d.DecodeElement(v, &start)
+			} else {
+				err = d.Skip()
+			}
+			if err != nil {
+				return decodeError(err, statusCode)
+			}
+		}
+	}
+}
+
+// decodeError reports a reply that cannot be decoded by its HTTP status, if
+// any, as the device may not reply with a SOAP envelope then
+func decodeError(err error, statusCode int) error {
+	if errors.Is(err, onvif.ErrResponseTooLarge) {
+		return err
+	}
+	if statusErr := gosoap.FaultFromReply(statusCode, nil); statusErr != nil {
+		return statusErr
+	}
 	return errors.Annotate(err, "decode")
 }
+
+// replyFields returns the fields of the Body of the struct reply points to,
+// by the local names of their elements, nil if reply has no Body
+func replyFields(reply interface{}) func(local string) interface{} {
+	v := reflect.ValueOf(reply)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil
+	}
+	body := v.Elem().FieldByName("Body")
+	if body.Kind() != reflect.Struct {
+		return nil
+	}
+	return func(local string) interface{} {
+		if field := bodyField(body, local); field.IsValid() {
+			return field.Addr().Interface()
+		}
+		return nil
+	}
+}
+
+// bodyField returns the field of body the element named local is decoded into
+func bodyField(body reflect.Value, local string) reflect.Value {
+	t := body.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("xml"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		// Either "name" or "namespace name"
+		name = name[strings.LastIndex(name, " ")+1:]
+		if name == "" {
+			name = field.Name
+		}
+		if name == local {
+			return body.Field(i)
+		}
+	}
+	return reflect.Value{}
+}
+
+// readMTOM decodes the envelope of an MTOM reply, and binds its attachments
+// to reply. The body is closed once they are read, or when ctx is done. The
+// parts held in memory are bounded by limit: the envelope, reported with
+// onvif.ErrResponseTooLarge beyond it, and the attachments read out of order,
+// whose reading fails with gosoap.ErrMTOMBufferFull.
+func readMTOM(ctx context.Context, httpReply *http.Response, reply interface{}, element func(local string) interface{}, stop func() bool, limit int64) error {
+	r, err := gosoap.NewMTOMReaderLimit(httpReply.Header.Get("Content-Type"), httpReply.Body, limit)
+	if err != nil {
+		stop()
+		httpReply.Body.Close()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if errors.Is(err, gosoap.ErrMTOMBufferFull) {
+			return onvif.ErrResponseTooLarge
+		}
+		return errors.Annotate(err, "read")
+	}
+
+	err = decodeEnvelope(xml.NewDecoder(bytes.NewReader(r.Envelope)), httpReply.StatusCode, reply, element)
+	if err != nil || gosoap.BindAttachments(reply, r) == 0 {
+		stop()
+		r.Close()
+	}
+	return err
+}
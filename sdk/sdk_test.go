@@ -0,0 +1,208 @@
+package sdk
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BalkarSandhu/go-onvif/device"
+	"github.com/BalkarSandhu/go-onvif/gosoap"
+	"github.com/BalkarSandhu/go-onvif/onvif"
+
+	"github.com/juju/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testEnvelopeStart = `<?xml version="1.0" encoding="UTF-8"?>
+<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope"
+	xmlns:tt="http://www.onvif.org/ver10/schema"
+	xmlns:tds="http://www.onvif.org/ver10/device/wsdl"
+	xmlns:ter="http://www.onvif.org/ver10/error"><env:Body>`
+
+const testEnvelopeEnd = `</env:Body></env:Envelope>`
+
+// newTestDevice returns a Device whose services are all served by handler
+func newTestDevice(t *testing.T, params onvif.DeviceParams, handler http.HandlerFunc) *onvif.Device {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+
+	params.Xaddr = srv.URL
+	endpoints := make(map[string]string)
+	for _, key := range []string{"device", "media", "media2", "imaging", "analytics", "recording"} {
+		endpoints[key] = srv.URL + "/onvif/" + key + "_service"
+	}
+	dev, err := onvif.RestoreDevice(params, onvif.DeviceState{Endpoints: endpoints})
+	require.NoError(t, err)
+	return dev
+}
+
+func writeEnvelope(w http.ResponseWriter, status int, body string) {
+	w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+	w.WriteHeader(status)
+	io.WriteString(w, testEnvelopeStart+body+testEnvelopeEnd)
+}
+
+func TestReadAndParseFault(t *testing.T) {
+	dev := newTestDevice(t, onvif.DeviceParams{}, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusInternalServerError, `<env:Fault>
+			<env:Code><env:Value>env:Receiver</env:Value>
+				<env:Subcode><env:Value>ter:ActionNotSupported</env:Value></env:Subcode></env:Code>
+			<env:Reason><env:Text xml:lang="en">Optional Action Not Implemented</env:Text></env:Reason>
+		</env:Fault>`)
+	})
+
+	ctx := context.Background()
+	resp, err := dev.CallMethodContext(ctx, device.GetSystemBackup{})
+	require.NoError(t, err)
+	var reply struct {
+		Body struct {
+			GetSystemBackupResponse device.GetSystemBackupResponse
+		}
+	}
+	err = errors.Annotate(ReadAndParse(ctx, resp, &reply, "GetSystemBackup"), "backup")
+
+	assert.True(t, gosoap.IsActionNotSupported(err))
+	assert.False(t, gosoap.IsNotAuthorized(err))
+	fault, ok := gosoap.AsFault(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusInternalServerError, fault.StatusCode)
+	assert.Equal(t, "Optional Action Not Implemented", fault.Reason)
+}
+
+func TestCallDeadline(t *testing.T) {
+	dev := newTestDevice(t, onvif.DeviceParams{}, func(w http.ResponseWriter, r *http.Request) {
+		// The reply stalls after its headers and the start of its envelope
+		w.Header().Set("Content-Type", "application/soap+xml; charset=utf-8")
+		w.WriteHeader(http.StatusOK)
+		io.WriteString(w, testEnvelopeStart)
+		w.(http.Flusher).Flush()
+		<-r.Context().Done()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := Call[device.GetDeviceInformation, device.GetDeviceInformationResponse](ctx, dev, device.GetDeviceInformation{})
+	assert.True(t, errors.Is(err, context.DeadlineExceeded), "%v", err)
+	assert.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestCallMissingReply(t *testing.T) {
+	dev := newTestDevice(t, onvif.DeviceParams{}, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusOK, ``)
+	})
+
+	_, err := Call[device.GetDeviceInformation, device.GetDeviceInformationResponse](context.Background(), dev, device.GetDeviceInformation{})
+	assert.True(t, errors.IsNotFound(err), "%v", err)
+}
+
+func TestCallPointerRequest(t *testing.T) {
+	var path string
+	dev := newTestDevice(t, onvif.DeviceParams{}, func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		writeEnvelope(w, http.StatusOK, `<tds:GetDeviceInformationResponse><tds:Manufacturer>ACME</tds:Manufacturer></tds:GetDeviceInformationResponse>`)
+	})
+
+	reply, err := Call[*device.GetDeviceInformation, device.GetDeviceInformationResponse](context.Background(), dev, &device.GetDeviceInformation{})
+	require.NoError(t, err)
+	assert.Equal(t, "ACME", string(reply.Manufacturer))
+	assert.Equal(t, "/onvif/device_service", path)
+
+	_, err = Call[*device.GetDeviceInformation, *device.GetDeviceInformationResponse](context.Background(), dev, &device.GetDeviceInformation{})
+	assert.True(t, errors.IsNotValid(err), "%v", err)
+	_, err = Call[*device.GetDeviceInformation, device.GetDeviceInformationResponse](context.Background(), dev, nil)
+	assert.True(t, errors.IsNotValid(err), "%v", err)
+}
+
+func TestCallNotAuthorized(t *testing.T) {
+	dev := newTestDevice(t, onvif.DeviceParams{Username: "admin", Password: "secret"}, func(w http.ResponseWriter, r *http.Request) {
+		// Rejected at the HTTP level, without SOAP fault
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+	})
+
+	_, err := Call[device.GetUsers, device.GetUsersResponse](context.Background(), dev, device.GetUsers{})
+	require.Error(t, err)
+	assert.True(t, gosoap.IsNotAuthorized(err))
+	assert.False(t, gosoap.IsActionNotSupported(err))
+	fault, ok := gosoap.AsFault(err)
+	require.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, fault.StatusCode)
+}
+
+func TestCallSenderFault(t *testing.T) {
+	dev := newTestDevice(t, onvif.DeviceParams{Username: "admin", Password: "secret"}, func(w http.ResponseWriter, r *http.Request) {
+		writeEnvelope(w, http.StatusBadRequest, `<env:Fault>
+			<env:Code><env:Value>env:Sender</env:Value>
+				<env:Subcode><env:Value>ter:NotAuthorized</env:Value></env:Subcode></env:Code>
+			<env:Reason><env:Text xml:lang="en">Sender not Authorized</env:Text></env:Reason>
+		</env:Fault>`)
+	})
+
+	_, err := Call[device.GetUsers, device.GetUsersResponse](context.Background(), dev, device.GetUsers{})
+	assert.True(t, gosoap.IsNotAuthorized(err))
+	assert.Contains(t, err.Error(), "Sender not Authorized")
+}
+
+// writeMTOM answers with an MTOM message whose root holds body, preceded by
+// the parts before and followed by the parts after, by their Content-ID
+func writeMTOM(w http.ResponseWriter, body string, before, after map[string]string) {
+	mw := multipart.NewWriter(w)
+	w.Header().Set("Content-Type", `multipart/related; type="application/xop+xml"; start="<root>"; start-info="application/soap+xml"; boundary=`+mw.Boundary())
+	writePart := func(id, content string) {
+		header := textproto.MIMEHeader{}
+		header.Set("Content-ID", "<"+id+">")
+		part, _ := mw.CreatePart(header)
+		io.WriteString(part, content)
+	}
+	for id, content := range before {
+		writePart(id, content)
+	}
+	writePart("root", testEnvelopeStart+body+testEnvelopeEnd)
+	for id, content := range after {
+		writePart(id, content)
+	}
+	mw.Close()
+}
+
+const systemBackup = `<tds:GetSystemBackupResponse><tds:BackupFiles><tt:Name>config</tt:Name>
+	<tt:Data><xop:Include xmlns:xop="http://www.w3.org/2004/08/xop/include" href="cid:backup"/></tt:Data>
+</tds:BackupFiles></tds:GetSystemBackupResponse>`
+
+func TestCallMTOM(t *testing.T) {
+	backup := strings.Repeat("b", 8192)
+	dev := newTestDevice(t, onvif.DeviceParams{MaxResponseSize: 2048}, func(w http.ResponseWriter, r *http.Request) {
+		writeMTOM(w, systemBackup, nil, map[string]string{"backup": backup})
+	})
+
+	// The attachment is streamed, beyond the maximum response size
+	reply, err := Call[device.GetSystemBackup, device.GetSystemBackupResponse](context.Background(), dev, device.GetSystemBackup{})
+	require.NoError(t, err)
+	assert.Equal(t, "config", reply.BackupFiles.Name)
+	require.NotNil(t, reply.BackupFiles.Data.Content)
+	data, err := io.ReadAll(reply.BackupFiles.Data.Content)
+	require.NoError(t, err)
+	assert.Equal(t, backup, string(data))
+}
+
+func TestCallMTOMTooLarge(t *testing.T) {
+	// The parts before the root are held in memory
+	before := make(map[string]string)
+	for i := 0; i < 200; i++ {
+		before[strconv.Itoa(i)] = ""
+	}
+	dev := newTestDevice(t, onvif.DeviceParams{MaxResponseSize: 16 * 1024}, func(w http.ResponseWriter, r *http.Request) {
+		writeMTOM(w, systemBackup, before, map[string]string{"backup": "backup"})
+	})
+
+	_, err := Call[device.GetSystemBackup, device.GetSystemBackupResponse](context.Background(), dev, device.GetSystemBackup{})
+	assert.ErrorIs(t, err, onvif.ErrResponseTooLarge)
+}
@@ -27,7 +27,10 @@ func SendProbe(interfaceName string, scopes, types []string, namespaces map[stri
 	uuidV4 := uuid.Must(uuid.NewV4())
 	//fmt.Printf("UUIDv4: %s\n", uuidV4)
 
-	probeSOAP := buildProbeMessage(uuidV4.String(), scopes, types, namespaces)
+	probeSOAP, err := buildProbeMessage(uuidV4.String(), scopes, types, namespaces)
+	if err != nil {
+		return nil, err
+	}
 	//probeSOAP = `<?xml version="1.0" encoding="UTF-8"?>
 	//<Envelope xmlns="http://www.w3.org/2003/05/soap-envelope" xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing">
 	//<Header>
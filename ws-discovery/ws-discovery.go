@@ -7,13 +7,13 @@ import (
 	"github.com/beevik/etree"
 )
 
-func buildProbeMessage(uuidV4 string, scopes, types []string, nmsp map[string]string) gosoap.SoapMessage {
+func buildProbeMessage(uuidV4 string, scopes, types []string, nmsp map[string]string) (gosoap.SoapMessage, error) {
 	//Список namespace
 	namespaces := make(map[string]string)
 	namespaces["a"] = "http://schemas.xmlsoap.org/ws/2004/08/addressing"
 	//namespaces["d"] = "http://schemas.xmlsoap.org/ws/2005/04/discovery"
 
-	probeMessage := gosoap.NewEmptySOAP()
+	probeMessage := gosoap.NewEnvelope()
 
 	probeMessage.AddRootNamespaces(namespaces)
 	//if len(nmsp) != 0 {
@@ -40,7 +40,9 @@ func buildProbeMessage(uuidV4 string, scopes, types []string, nmsp map[string]st
 	to.CreateAttr("mustUnderstand", "1")
 
 	headerContent = append(headerContent, action, msgID, replyTo, to)
-	probeMessage.AddHeaderContents(headerContent)
+	for _, j := range headerContent {
+		probeMessage.AddHeaderContent(j)
+	}
 
 	//Содержимое Body
 	probe := etree.NewElement("Probe")
@@ -80,5 +82,5 @@ func buildProbeMessage(uuidV4 string, scopes, types []string, nmsp map[string]st
 
 	probeMessage.AddBodyContent(probe)
 
-	return probeMessage
+	return probeMessage.SoapMessage()
 }
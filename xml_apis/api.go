@@ -225,9 +225,15 @@ func (s *APIServer) callNecessaryMethod(serviceName, methodName, acceptedData, u
 	}
 
 	soap := gosoap.NewEmptySOAP()
-	soap.AddStringBodyContent(*resp)
-	soap.AddRootNamespaces(onvif.Xlmns)
-	soap.AddWSSecurity(username, password)
+	if err := soap.AddStringBodyContentErr(*resp); err != nil {
+		return "", errors.Annotate(err, "AddStringBodyContent")
+	}
+	if err := soap.AddRootNamespacesErr(onvif.Xlmns); err != nil {
+		return "", errors.Annotate(err, "AddRootNamespaces")
+	}
+	if err := soap.AddWSSecurityErr(username, password); err != nil {
+		return "", errors.Annotate(err, "AddWSSecurity")
+	}
 
 	servResp, err := networking.SendSoap(new(http.Client), endpoint, soap.String())
 	if err != nil {
@@ -184,8 +184,21 @@ type Duration AnySimpleType
 
 /*
 Construct an instance of xsd duration type
+
+Deprecated: use NewDuration, which reports invalid values.
 */
 func (tp Duration) NewDateTime(years, months, days, hours, minutes, seconds string) Duration {
+	d, err := tp.NewDuration(years, months, days, hours, minutes, seconds)
+	if err != nil {
+		log.Println(err)
+	}
+	return d
+}
+
+/*
+Construct an instance of xsd duration type, or return an error if a value is not a number
+*/
+func (tp Duration) NewDuration(years, months, days, hours, minutes, seconds string) (Duration, error) {
 	i, err := iso8601.NewDuration(
 		years,
 		months,
@@ -194,14 +207,11 @@ func (tp Duration) NewDateTime(years, months, days, hours, minutes, seconds stri
 		minutes,
 		seconds,
 	)
-
 	if err != nil {
-		log.Fatalln(err)
+		return Duration(""), err
 	}
 
-	//fmt.Println(i.ISO8601Duration())
-
-	return Duration(i.ISO8601Duration())
+	return Duration(i.ISO8601Duration()), nil
 }
 
 /*
@@ -0,0 +1,114 @@
+package onvif
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"io"
+	"strings"
+
+	"github.com/BalkarSandhu/go-onvif/xsd"
+)
+
+// XOPHref returns the cid: URI of the MTOM attachment holding the content
+func (a *AttachmentData) XOPHref() string {
+	return string(a.Include.Href)
+}
+
+// SetXOPHref references the MTOM attachment holding the content
+func (a *AttachmentData) SetXOPHref(href string) {
+	a.Include.Href = xsd.AnyURI(href)
+}
+
+// XOPContent returns the content type and the content
+func (a *AttachmentData) XOPContent() (string, io.Reader) {
+	return string(a.ContentType), a.Content
+}
+
+// SetXOPContent sets the content
+func (a *AttachmentData) SetXOPContent(content io.Reader) {
+	a.Content = content
+}
+
+// MarshalXML writes a xop:Include when the content is an MTOM attachment,
+// and the content encoded in base64 otherwise
+func (a AttachmentData) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if a.ContentType != "" {
+		start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "xmime:contentType"}, Value: string(a.ContentType)})
+	}
+	if err := e.EncodeToken(start); err != nil {
+		return err
+	}
+
+	switch {
+	case a.Include.Href != "":
+		include := xml.StartElement{
+			Name: xml.Name{Local: "xop:Include"},
+			Attr: []xml.Attr{{Name: xml.Name{Local: "href"}, Value: string(a.Include.Href)}},
+		}
+		if err := e.EncodeToken(include); err != nil {
+			return err
+		}
+		if err := e.EncodeToken(include.End()); err != nil {
+			return err
+		}
+	case a.Content != nil:
+		var encoded strings.Builder
+		w := base64.NewEncoder(base64.StdEncoding, &encoded)
+		if _, err := io.Copy(w, a.Content); err != nil {
+			return err
+		}
+		w.Close()
+		if err := e.EncodeToken(xml.CharData(encoded.String())); err != nil {
+			return err
+		}
+	}
+	return e.EncodeToken(start.End())
+}
+
+// UnmarshalXML accepts both a xop:Include and an inline content in base64,
+// whatever the prefixes used by the device
+func (a *AttachmentData) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		Include *struct {
+			Href string `xml:"href,attr"`
+		} `xml:"Include"`
+		Text string `xml:",chardata"`
+	}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+
+	*a = AttachmentData{}
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "contentType" {
+			a.ContentType = ContentType(attr.Value)
+		}
+	}
+	if raw.Include != nil {
+		a.Include.Href = xsd.AnyURI(strings.TrimSpace(raw.Include.Href))
+		return nil
+	}
+	if text := strings.Join(strings.Fields(raw.Text), ""); text != "" {
+		data, err := base64.StdEncoding.DecodeString(text)
+		if err != nil {
+			return err
+		}
+		a.Content = bytes.NewReader(data)
+	}
+	return nil
+}
+
+// UnmarshalXML decodes a BackupFile, whatever the prefixes used by the device
+func (b *BackupFile) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw struct {
+		Name string         `xml:"Name"`
+		Data AttachmentData `xml:"Data"`
+	}
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+	b.Name = strings.TrimSpace(raw.Name)
+	b.Data = raw.Data
+	return nil
+}
@@ -0,0 +1,119 @@
+package onvif
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+
+	"github.com/BalkarSandhu/go-onvif/xsd"
+)
+
+// ErrNoItem is returned for a parameter a Config does not hold
+var ErrNoItem = errors.New("no such parameter")
+
+// SimpleItem returns the value of the simple parameter name, and if it is present
+func (c Config) SimpleItem(name string) (string, bool) {
+	for _, item := range c.Parameters.SimpleItem {
+		if item.Name == name {
+			return string(item.Value), true
+		}
+	}
+	return "", false
+}
+
+// SetSimpleItem sets the value of the simple parameter name, adding it if missing
+func (c *Config) SetSimpleItem(name, value string) {
+	for i := range c.Parameters.SimpleItem {
+		if c.Parameters.SimpleItem[i].Name == name {
+			c.Parameters.SimpleItem[i].Value = xsd.AnySimpleType(value)
+			return
+		}
+	}
+	c.Parameters.SimpleItem = append(c.Parameters.SimpleItem, SimpleItem{Name: name, Value: xsd.AnySimpleType(value)})
+}
+
+// ElementItem returns the XML element of the element parameter name, and if it is present
+func (c Config) ElementItem(name string) (string, bool) {
+	for _, item := range c.Parameters.ElementItem {
+		if item.Name == name {
+			return item.Any, true
+		}
+	}
+	return "", false
+}
+
+// DecodeElementItem decodes the XML element of the element parameter name into v
+func (c Config) DecodeElementItem(name string, v interface{}) error {
+	element, ok := c.ElementItem(name)
+	if !ok {
+		return ErrNoItem
+	}
+	return xml.Unmarshal([]byte(element), v)
+}
+
+// SetElementItem sets the XML element of the element parameter name, adding it if missing.
+// The element declares the namespaces it uses, e.g.
+// <tt:Polygon xmlns:tt="http://www.onvif.org/ver10/schema">...</tt:Polygon>
+func (c *Config) SetElementItem(name, element string) {
+	for i := range c.Parameters.ElementItem {
+		if c.Parameters.ElementItem[i].Name == name {
+			c.Parameters.ElementItem[i].Any = element
+			return
+		}
+	}
+	c.Parameters.ElementItem = append(c.Parameters.ElementItem, ElementItem{Name: name, Any: element})
+}
+
+// UnmarshalXML decodes a Config, whatever the prefixes used by the device
+func (c *Config) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Config
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML keeps the content of an ElementItem as an XML element
+// declaring its namespaces, so that it can be decoded or sent back on its
+// own, away from the declarations of the reply
+func (e *ElementItem) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	*e = ElementItem{}
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "Name" {
+			e.Name = attr.Value
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := xml.NewEncoder(&buf)
+	for depth := 0; ; {
+		tok, err := d.Token()
+		if err != nil {
+			return err
+		}
+		switch t := tok.(type) {
+		case xml.StartElement:
+			depth++
+			t.Attr = WithoutNamespaceDeclarations(t.Attr)
+			t.Name = unqualified(t.Name)
+			tok = t
+		case xml.EndElement:
+			t.Name = unqualified(t.Name)
+			tok = t
+			if depth == 0 {
+				if err := enc.Flush(); err != nil {
+					return err
+				}
+				e.Any = buf.String()
+				return nil
+			}
+			depth--
+		case xml.CharData:
+			if depth == 0 {
+				continue
+			}
+		case xml.ProcInst, xml.Directive, xml.Comment:
+			continue
+		}
+		if err := enc.EncodeToken(xml.CopyToken(tok)); err != nil {
+			return err
+		}
+	}
+}
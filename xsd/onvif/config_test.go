@@ -0,0 +1,57 @@
+package onvif
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPolygon = `<tt:Polygon xmlns:tt="http://www.onvif.org/ver10/schema"><tt:Point x="0" y="0"></tt:Point><tt:Point x="1" y="1"></tt:Point></tt:Polygon>`
+
+func TestConfigRoundTrip(t *testing.T) {
+	var rule Config
+	rule.Name = "Motion"
+	rule.Type = "tt:CellMotionDetector"
+	rule.SetSimpleItem("MinCount", "5")
+	rule.SetElementItem("Field", testPolygon)
+
+	var got Config
+	roundTrip(t, rule, &got)
+	assert.Equal(t, rule.Name, got.Name)
+	assert.Equal(t, rule.Type, got.Type)
+	count, ok := got.SimpleItem("MinCount")
+	assert.True(t, ok)
+	assert.Equal(t, "5", count)
+
+	// The element parameter decodes on its own and can be sent back as is
+	var polygon Polygon
+	require.NoError(t, got.DecodeElementItem("Field", &polygon))
+	assert.Len(t, polygon.Point, 2)
+	var again Config
+	roundTrip(t, got, &again)
+	assert.Equal(t, got, again)
+
+	assert.ErrorIs(t, got.DecodeElementItem("Missing", &polygon), ErrNoItem)
+}
+
+func TestConfigDecodeReply(t *testing.T) {
+	// The namespaces are declared by the envelope, not by the Rule
+	const reply = `<env:Body xmlns:env="http://www.w3.org/2003/05/soap-envelope"
+		xmlns:tan="http://www.onvif.org/ver20/analytics/wsdl" xmlns:tt="http://www.onvif.org/ver10/schema">
+		<tan:Rule Name="Motion" Type="tt:CellMotionDetector"><tt:Parameters>
+			<tt:SimpleItem Name="MinCount" Value="5"/>
+			<tt:ElementItem Name="Field"><tt:Polygon><tt:Point x="0" y="0"/><tt:Point x="1" y="1"/></tt:Polygon></tt:ElementItem>
+		</tt:Parameters></tan:Rule></env:Body>`
+	var body struct {
+		Rule Config
+	}
+	require.NoError(t, xml.Unmarshal([]byte(reply), &body))
+	assert.Equal(t, "Motion", body.Rule.Name)
+	count, _ := body.Rule.SimpleItem("MinCount")
+	assert.Equal(t, "5", count)
+	var polygon Polygon
+	require.NoError(t, body.Rule.DecodeElementItem("Field", &polygon))
+	assert.Equal(t, []Vector{{0, 0}, {1, 1}}, polygon.Point)
+}
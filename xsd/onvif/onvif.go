@@ -1,6 +1,9 @@
 package onvif
 
 import (
+	"encoding/xml"
+	"io"
+
 	"github.com/BalkarSandhu/go-onvif/xsd"
 )
 
@@ -58,7 +61,7 @@ type FloatRange struct {
 }
 
 type OSDConfiguration struct {
-	DeviceEntity                  `xml:"token,attr"`
+	DeviceEntity
 	VideoSourceConfigurationToken OSDReference              `xml:"onvif:VideoSourceConfigurationToken"`
 	Type                          OSDType                   `xml:"onvif:Type"`
 	Position                      OSDPosConfiguration       `xml:"onvif:Position"`
@@ -317,9 +320,9 @@ type ToneCompensation struct {
 type ToneCompensationExtension xsd.AnyType
 
 type Defogging struct {
-	Mode      string
-	Level     float64
-	Extension DefoggingExtension
+	Mode      string             `xml:"onvif:Mode"`
+	Level     float64            `xml:"onvif:Level"`
+	Extension DefoggingExtension `xml:"onvif:Extension"`
 }
 
 type DefoggingExtension xsd.AnyType
@@ -474,6 +477,64 @@ type IPv4Address xsd.Token
 // IPv6 address
 type IPv6Address xsd.Token
 
+// VideoEncoder2Configuration is the video encoder configuration of Media2,
+// H265 included
+type VideoEncoder2Configuration struct {
+	ConfigurationEntity
+	GovLength           int                     `xml:"GovLength,attr,omitempty"`
+	Profile             string                  `xml:"Profile,attr,omitempty"`
+	GuaranteedFrameRate *xsd.Boolean            `xml:"GuaranteedFrameRate,attr"`
+	Encoding            string                  `xml:"onvif:Encoding"`
+	Resolution          VideoResolution2        `xml:"onvif:Resolution"`
+	RateControl         *VideoRateControl2      `xml:"onvif:RateControl"`
+	Multicast           *MulticastConfiguration `xml:"onvif:Multicast"`
+	Quality             float64                 `xml:"onvif:Quality"`
+}
+
+type VideoResolution2 struct {
+	Width  xsd.Int `xml:"onvif:Width"`
+	Height xsd.Int `xml:"onvif:Height"`
+}
+
+type VideoRateControl2 struct {
+	ConstantBitRate *xsd.Boolean `xml:"ConstantBitRate,attr"`
+	FrameRateLimit  float64      `xml:"onvif:FrameRateLimit"`
+	BitrateLimit    xsd.Int      `xml:"onvif:BitrateLimit"`
+}
+
+type VideoEncoder2ConfigurationOptions struct {
+	GovLengthRange               xsd.AnySimpleType `xml:"GovLengthRange,attr"`
+	FrameRatesSupported          xsd.AnySimpleType `xml:"FrameRatesSupported,attr"`
+	ProfilesSupported            xsd.AnySimpleType `xml:"ProfilesSupported,attr"`
+	ConstantBitRateSupported     xsd.Boolean       `xml:"ConstantBitRateSupported,attr"`
+	GuaranteedFrameRateSupported xsd.Boolean       `xml:"GuaranteedFrameRateSupported,attr"`
+	Encoding                     string
+	QualityRange                 FloatRange
+	ResolutionsAvailable         []VideoResolution2
+	BitrateRange                 IntRange
+	Extension                    VideoEncoder2ConfigurationOptionsExtension
+}
+
+type VideoEncoder2ConfigurationOptionsExtension xsd.AnyType
+
+type AudioEncoder2Configuration struct {
+	ConfigurationEntity
+	Encoding   string                  `xml:"onvif:Encoding"`
+	Multicast  *MulticastConfiguration `xml:"onvif:Multicast"`
+	Bitrate    int                     `xml:"onvif:Bitrate"`
+	SampleRate int                     `xml:"onvif:SampleRate"`
+}
+
+type AudioEncoder2ConfigurationOptions struct {
+	Encoding       string
+	BitrateList    IntItems
+	SampleRateList IntItems
+}
+
+type IntItems struct {
+	Items []int
+}
+
 type AudioEncoderConfiguration struct {
 	ConfigurationEntity
 	Encoding       AudioEncoding          `xml:"onvif:Encoding"`
@@ -492,10 +553,12 @@ type VideoAnalyticsConfiguration struct {
 }
 
 type AnalyticsEngineConfiguration struct {
-	AnalyticsModule Config                                `xml:"onvif:AnalyticsModule"`
+	AnalyticsModule []Config                              `xml:"onvif:AnalyticsModule"`
 	Extension       AnalyticsEngineConfigurationExtension `xml:"onvif:Extension"`
 }
 
+// Config is a rule or an analytics module, its parameters being read and
+// written by name with the SimpleItem and ElementItem accessors
 type Config struct {
 	Name       string    `xml:"Name,attr"`
 	Type       xsd.QName `xml:"Type,attr"`
@@ -503,9 +566,9 @@ type Config struct {
 }
 
 type ItemList struct {
-	SimpleItem  SimpleItem        `xml:"onvif:SimpleItem"`
-	ElementItem ElementItem       `xml:"onvif:ElementItem"`
-	Extension   ItemListExtension `xml:"onvif:Extension"`
+	SimpleItem  []SimpleItem      `xml:"onvif:SimpleItem"`
+	ElementItem []ElementItem     `xml:"onvif:ElementItem"`
+	Extension   ItemListExtension `xml:"onvif:Extension,omitempty"`
 }
 
 type SimpleItem struct {
@@ -513,16 +576,80 @@ type SimpleItem struct {
 	Value xsd.AnySimpleType `xml:"Value,attr"`
 }
 
+// ElementItem holds a parameter of any type, e.g. a tt:Polygon, as XML
 type ElementItem struct {
 	Name string `xml:"Name,attr"`
+	Any  string `xml:",innerxml"`
 }
 
 type ItemListExtension xsd.AnyType
 
+type SupportedRules struct {
+	RuleContentSchemaLocation []xsd.AnyURI
+	RuleDescription           []ConfigDescription
+	Extension                 SupportedRulesExtension
+}
+
+type SupportedRulesExtension xsd.AnyType
+
+type SupportedAnalyticsModules struct {
+	AnalyticsModuleContentSchemaLocation []xsd.AnyURI
+	AnalyticsModuleDescription           []ConfigDescription
+	Extension                            SupportedAnalyticsModulesExtension
+}
+
+type SupportedAnalyticsModulesExtension xsd.AnyType
+
+// ConfigDescription describes the parameters of a type of rule or analytics
+// module, and the events it produces
+type ConfigDescription struct {
+	Name         xsd.QName   `xml:"Name,attr"`
+	Fixed        xsd.Boolean `xml:"fixed,attr"`
+	MaxInstances xsd.Integer `xml:"maxInstances,attr"`
+	Parameters   ItemListDescription
+	Messages     []ConfigDescriptionMessages
+	Extension    ConfigDescriptionExtension
+}
+
+type ConfigDescriptionMessages struct {
+	MessageDescription
+	ParentTopic string
+}
+
+type ConfigDescriptionExtension xsd.AnyType
+
+type ItemListDescription struct {
+	SimpleItemDescription  []SimpleItemDescription
+	ElementItemDescription []ElementItemDescription
+	Extension              ItemListDescriptionExtension
+}
+
+type SimpleItemDescription struct {
+	Name string    `xml:"Name,attr"`
+	Type xsd.QName `xml:"Type,attr"`
+}
+
+type ElementItemDescription struct {
+	Name string    `xml:"Name,attr"`
+	Type xsd.QName `xml:"Type,attr"`
+}
+
+type ItemListDescriptionExtension xsd.AnyType
+
+type MessageDescription struct {
+	IsProperty xsd.Boolean `xml:"IsProperty,attr"`
+	Source     ItemListDescription
+	Key        ItemListDescription
+	Data       ItemListDescription
+	Extension  MessageDescriptionExtension
+}
+
+type MessageDescriptionExtension xsd.AnyType
+
 type AnalyticsEngineConfigurationExtension xsd.AnyType
 
 type RuleEngineConfiguration struct {
-	Rule      Config                           `xml:"onvif:Rule"`
+	Rule      []Config                         `xml:"onvif:Rule"`
 	Extension RuleEngineConfigurationExtension `xml:"onvif:Extension"`
 }
 
@@ -533,18 +660,18 @@ type PTZConfiguration struct {
 	MoveRamp                               int                       `xml:"MoveRamp,attr"`
 	PresetRamp                             int                       `xml:"PresetRamp,attr"`
 	PresetTourRamp                         int                       `xml:"PresetTourRamp,attr"`
-	NodeToken                              ReferenceToken            `xml:"NodeToken"`
-	DefaultAbsolutePantTiltPositionSpace   xsd.AnyURI                `xml:"DefaultAbsolutePantTiltPositionSpace"`
-	DefaultAbsoluteZoomPositionSpace       xsd.AnyURI                `xml:"DefaultAbsoluteZoomPositionSpace"`
-	DefaultRelativePanTiltTranslationSpace xsd.AnyURI                `xml:"DefaultRelativePanTiltTranslationSpace"`
-	DefaultRelativeZoomTranslationSpace    xsd.AnyURI                `xml:"DefaultRelativeZoomTranslationSpace"`
-	DefaultContinuousPanTiltVelocitySpace  xsd.AnyURI                `xml:"DefaultContinuousPanTiltVelocitySpace"`
-	DefaultContinuousZoomVelocitySpace     xsd.AnyURI                `xml:"DefaultContinuousZoomVelocitySpace"`
-	DefaultPTZSpeed                        PTZSpeed                  `xml:"DefaultPTZSpeed"`
-	DefaultPTZTimeout                      xsd.Duration              `xml:"DefaultPTZTimeout"`
-	PanTiltLimits                          PanTiltLimits             `xml:"PanTiltLimits"`
-	ZoomLimits                             ZoomLimits                `xml:"ZoomLimits"`
-	Extension                              PTZConfigurationExtension `xml:"Extension"`
+	NodeToken                              ReferenceToken            `xml:"onvif:NodeToken"`
+	DefaultAbsolutePantTiltPositionSpace   xsd.AnyURI                `xml:"onvif:DefaultAbsolutePantTiltPositionSpace"`
+	DefaultAbsoluteZoomPositionSpace       xsd.AnyURI                `xml:"onvif:DefaultAbsoluteZoomPositionSpace"`
+	DefaultRelativePanTiltTranslationSpace xsd.AnyURI                `xml:"onvif:DefaultRelativePanTiltTranslationSpace"`
+	DefaultRelativeZoomTranslationSpace    xsd.AnyURI                `xml:"onvif:DefaultRelativeZoomTranslationSpace"`
+	DefaultContinuousPanTiltVelocitySpace  xsd.AnyURI                `xml:"onvif:DefaultContinuousPanTiltVelocitySpace"`
+	DefaultContinuousZoomVelocitySpace     xsd.AnyURI                `xml:"onvif:DefaultContinuousZoomVelocitySpace"`
+	DefaultPTZSpeed                        PTZSpeed                  `xml:"onvif:DefaultPTZSpeed"`
+	DefaultPTZTimeout                      xsd.Duration              `xml:"onvif:DefaultPTZTimeout"`
+	PanTiltLimits                          PanTiltLimits             `xml:"onvif:PanTiltLimits"`
+	ZoomLimits                             ZoomLimits                `xml:"onvif:ZoomLimits"`
+	Extension                              PTZConfigurationExtension `xml:"onvif:Extension"`
 }
 
 type PTZSpeed struct {
@@ -845,7 +972,7 @@ type EncodingTypes struct {
 }
 
 type Description struct {
-	Description string
+	Description string `xml:",chardata"`
 }
 
 type VideoSourceModeExtension xsd.AnyType
@@ -1147,9 +1274,14 @@ type SystemDateTimeExtension xsd.AnyType
 
 type FactoryDefaultType xsd.String
 
+// AttachmentData is a binary content, inline or sent as an MTOM attachment
+// referenced by a xop:Include. See attachment.go for its (un)marshaling.
 type AttachmentData struct {
-	ContentType ContentType `xml:"contentType,attr"`
-	Include     Include     `xml:"inc:Include"`
+	ContentType ContentType `xml:"xmime:contentType,attr,omitempty"`
+	Include     Include     `xml:"xop:Include"`
+	// Content streams the binary content. When the device replied with an MTOM
+	// message, it must be read to its end or closed to release the connection.
+	Content io.Reader `xml:"-"`
 }
 
 type Include struct {
@@ -1827,18 +1959,44 @@ type LocalLocation struct {
 	Z xsd.Float `xml:"z,attr"`
 }
 
+type Polygon struct {
+	Point []Vector `xml:"onvif:Point"`
+}
+
 type GeoOrientation struct {
 	Roll  xsd.Float `xml:"roll,attr"`
 	Pitch xsd.Float `xml:"pitch,attr"`
 	Yaw   xsd.Float `xml:"yaw,attr"`
 }
 
+// FocusMove holds one of the Absolute, Relative or Continuous movements.
+// Only the non-zero ones are sent, the Absolute one if all of them are zero.
 type FocusMove struct {
 	Absolute   AbsoluteFocus   `xml:"onvif:Absolute"`
 	Relative   RelativeFocus   `xml:"onvif:Relative"`
 	Continuous ContinuousFocus `xml:"onvif:Continuous"`
 }
 
+// MarshalXML sends the movements set, as the devices reject a Focus holding
+// several of them
+func (m FocusMove) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	move := struct {
+		Absolute   *AbsoluteFocus   `xml:"onvif:Absolute,omitempty"`
+		Relative   *RelativeFocus   `xml:"onvif:Relative,omitempty"`
+		Continuous *ContinuousFocus `xml:"onvif:Continuous,omitempty"`
+	}{}
+	if m.Relative != (RelativeFocus{}) {
+		move.Relative = &m.Relative
+	}
+	if m.Continuous != (ContinuousFocus{}) {
+		move.Continuous = &m.Continuous
+	}
+	if m.Absolute != (AbsoluteFocus{}) || (move.Relative == nil && move.Continuous == nil) {
+		move.Absolute = &m.Absolute
+	}
+	return e.EncodeElement(move, start)
+}
+
 type ContinuousFocus struct {
 	Speed xsd.Float `xml:"onvif:Speed"`
 }
@@ -1853,6 +2011,152 @@ type AbsoluteFocus struct {
 	Speed    xsd.Float `xml:"onvif:Speed"`
 }
 
+type ImagingOptions20 struct {
+	BacklightCompensation BacklightCompensationOptions20
+	Brightness            FloatRange
+	ColorSaturation       FloatRange
+	Contrast              FloatRange
+	Exposure              ExposureOptions20
+	Focus                 FocusOptions20
+	IrCutFilterModes      []IrCutFilterMode
+	Sharpness             FloatRange
+	WideDynamicRange      WideDynamicRangeOptions20
+	WhiteBalance          WhiteBalanceOptions20
+	Extension             ImagingOptions20Extension
+}
+
+type BacklightCompensationOptions20 struct {
+	Mode  []BacklightCompensationMode
+	Level FloatRange
+}
+
+type ExposureOptions20 struct {
+	Mode            []ExposureMode
+	Priority        []ExposurePriority
+	MinExposureTime FloatRange
+	MaxExposureTime FloatRange
+	MinGain         FloatRange
+	MaxGain         FloatRange
+	MinIris         FloatRange
+	MaxIris         FloatRange
+	ExposureTime    FloatRange
+	Gain            FloatRange
+	Iris            FloatRange
+}
+
+type FocusOptions20 struct {
+	AutoFocusModes []AutoFocusMode
+	DefaultSpeed   FloatRange
+	NearLimit      FloatRange
+	FarLimit       FloatRange
+	Extension      FocusOptions20Extension
+}
+
+type FocusOptions20Extension struct {
+	AFModes StringAttrList
+}
+
+type WideDynamicRangeOptions20 struct {
+	Mode  []WideDynamicMode
+	Level FloatRange
+}
+
+type WhiteBalanceOptions20 struct {
+	Mode      []WhiteBalanceMode
+	YrGain    FloatRange
+	YbGain    FloatRange
+	Extension WhiteBalanceOptions20Extension
+}
+
+type WhiteBalanceOptions20Extension xsd.AnyType
+
+type ImagingOptions20Extension struct {
+	ImageStabilization ImageStabilizationOptions
+	Extension          ImagingOptions20Extension2
+}
+
+type ImageStabilizationOptions struct {
+	Mode      []ImageStabilizationMode
+	Level     FloatRange
+	Extension ImageStabilizationOptionsExtension
+}
+
+type ImageStabilizationOptionsExtension xsd.AnyType
+
+type ImagingOptions20Extension2 struct {
+	IrCutFilterAutoAdjustment IrCutFilterAutoAdjustmentOptions
+	Extension                 ImagingOptions20Extension3
+}
+
+type IrCutFilterAutoAdjustmentOptions struct {
+	BoundaryType      []string
+	BoundaryOffset    xsd.Boolean
+	ResponseTimeRange DurationRange
+	Extension         IrCutFilterAutoAdjustmentOptionsExtension
+}
+
+type IrCutFilterAutoAdjustmentOptionsExtension xsd.AnyType
+
+type ImagingOptions20Extension3 struct {
+	ToneCompensationOptions ToneCompensationOptions
+	DefoggingOptions        DefoggingOptions
+	NoiseReductionOptions   NoiseReductionOptions
+	Extension               ImagingOptions20Extension4
+}
+
+type ToneCompensationOptions struct {
+	Mode  []string
+	Level xsd.Boolean
+}
+
+type DefoggingOptions struct {
+	Mode  []string
+	Level xsd.Boolean
+}
+
+type NoiseReductionOptions struct {
+	Level xsd.Boolean
+}
+
+type ImagingOptions20Extension4 xsd.AnyType
+
+type MoveOptions20 struct {
+	Absolute   AbsoluteFocusOptions
+	Relative   RelativeFocusOptions20
+	Continuous ContinuousFocusOptions
+}
+
+type AbsoluteFocusOptions struct {
+	Position FloatRange
+	Speed    FloatRange
+}
+
+type RelativeFocusOptions20 struct {
+	Distance FloatRange
+	Speed    FloatRange
+}
+
+type ContinuousFocusOptions struct {
+	Speed FloatRange
+}
+
+type ImagingStatus20 struct {
+	FocusStatus20 FocusStatus20
+	Extension     ImagingStatus20Extension
+}
+
+type FocusStatus20 struct {
+	Position xsd.Float
+	// IDLE, MOVING or UNKNOWN
+	MoveStatus string
+	Error      string
+	Extension  FocusStatus20Extension
+}
+
+type FocusStatus20Extension xsd.AnyType
+
+type ImagingStatus20Extension xsd.AnyType
+
 type DateTime struct {
 	Time Time `xml:"onvif:Time"`
 	Date Date `xml:"onvif:Date"`
@@ -1869,3 +2173,130 @@ type Date struct {
 	Month xsd.Int `xml:"onvif:Month"`
 	Day   xsd.Int `xml:"onvif:Day"`
 }
+
+type RecordingReference ReferenceToken
+
+type TrackReference ReferenceToken
+
+type RecordingJobReference ReferenceToken
+
+// StorageReferencePath is the token of a storage configuration, optionally
+// followed by a relative path
+type StorageReferencePath xsd.String
+
+type XPathExpression xsd.String
+
+type RecordingConfiguration struct {
+	Source               RecordingSourceInformation `xml:"onvif:Source"`
+	Content              Description                `xml:"onvif:Content"`
+	MaximumRetentionTime xsd.Duration               `xml:"onvif:MaximumRetentionTime"`
+}
+
+type RecordingSourceInformation struct {
+	SourceId    xsd.AnyURI  `xml:"onvif:SourceId"`
+	Name        xsd.String  `xml:"onvif:Name"`
+	Location    Description `xml:"onvif:Location"`
+	Description Description `xml:"onvif:Description"`
+	Address     xsd.AnyURI  `xml:"onvif:Address"`
+}
+
+type TrackConfiguration struct {
+	TrackType   TrackType   `xml:"onvif:TrackType"`
+	Description Description `xml:"onvif:Description"`
+}
+
+// TrackType is Video, Audio, Metadata or Extended
+type TrackType xsd.String
+
+type GetRecordingsResponseItem struct {
+	RecordingToken RecordingReference
+	Configuration  RecordingConfiguration
+	Tracks         GetTracksResponseList
+}
+
+type GetTracksResponseList struct {
+	Track []GetTracksResponseItem
+}
+
+type GetTracksResponseItem struct {
+	TrackToken    TrackReference
+	Configuration TrackConfiguration
+}
+
+// RecordingJobMode is Idle or Active
+type RecordingJobMode xsd.String
+
+// RecordingJobState is Idle, Active, PartiallyActive or Error
+type RecordingJobState xsd.String
+
+type RecordingJobConfiguration struct {
+	ScheduleToken  xsd.String                          `xml:"ScheduleToken,attr,omitempty"`
+	RecordingToken RecordingReference                  `xml:"onvif:RecordingToken"`
+	Mode           RecordingJobMode                    `xml:"onvif:Mode"`
+	Priority       xsd.Int                             `xml:"onvif:Priority"`
+	Source         []RecordingJobSource                `xml:"onvif:Source"`
+	Extension      *RecordingJobConfigurationExtension `xml:"onvif:Extension"`
+}
+
+type RecordingJobConfigurationExtension xsd.AnyType
+
+type RecordingJobSource struct {
+	SourceToken        *SourceReference    `xml:"onvif:SourceToken"`
+	AutoCreateReceiver *xsd.Boolean        `xml:"onvif:AutoCreateReceiver"`
+	Tracks             []RecordingJobTrack `xml:"onvif:Tracks"`
+}
+
+// SourceReference is a media profile, by default, or a receiver whose Type
+// is http://www.onvif.org/ver10/schema/Receiver
+type SourceReference struct {
+	Type  xsd.AnyURI     `xml:"Type,attr,omitempty"`
+	Token ReferenceToken `xml:"onvif:Token"`
+}
+
+type RecordingJobTrack struct {
+	SourceTag   xsd.String     `xml:"onvif:SourceTag"`
+	Destination TrackReference `xml:"onvif:Destination"`
+}
+
+type GetRecordingJobsResponseItem struct {
+	JobToken         RecordingJobReference
+	JobConfiguration RecordingJobConfiguration
+}
+
+type RecordingJobStateInformation struct {
+	RecordingToken RecordingReference
+	State          RecordingJobState
+	Sources        []RecordingJobStateSource
+}
+
+type RecordingJobStateSource struct {
+	SourceToken SourceReference
+	State       RecordingJobState
+	Tracks      RecordingJobStateTracks
+}
+
+type RecordingJobStateTracks struct {
+	Track []RecordingJobStateTrack
+}
+
+type RecordingJobStateTrack struct {
+	SourceTag   xsd.String
+	Destination TrackReference
+	Error       xsd.String
+	State       RecordingJobState
+}
+
+type SearchScope struct {
+	IncludedSources            []SourceReference    `xml:"onvif:IncludedSources"`
+	IncludedRecordings         []RecordingReference `xml:"onvif:IncludedRecordings"`
+	RecordingInformationFilter XPathExpression      `xml:"onvif:RecordingInformationFilter,omitempty"`
+}
+
+type ArrayOfFileProgress struct {
+	FileProgress []FileProgress
+}
+
+type FileProgress struct {
+	FileName xsd.String
+	Progress xsd.Float
+}
@@ -0,0 +1,226 @@
+package onvif
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// The types sent in requests name their elements with a prefix, e.g. onvif,
+// so that they are marshaled in its namespace. Decoding matches names
+// without their prefix though, so the types also read from replies decode
+// the elements of this namespace renamed with the prefix, whatever the
+// prefix used by the device.
+
+const schemaNamespace = "http://www.onvif.org/ver10/schema"
+
+// DecodeQualified decodes the element start into v, the elements of
+// namespace it holds being renamed with prefix, as named by the fields of v
+func DecodeQualified(d *xml.Decoder, start xml.StartElement, v interface{}, namespace, prefix string) error {
+	prefix += ":"
+	return decodeRenamed(d, start, v, func(name xml.Name) xml.Name {
+		if name.Space == namespace && !strings.HasPrefix(name.Local, prefix) {
+			return xml.Name{Local: prefix + name.Local}
+		}
+		return name
+	})
+}
+
+func decodeQualified(d *xml.Decoder, start xml.StartElement, v interface{}) error {
+	return DecodeQualified(d, start, v, schemaNamespace, "onvif")
+}
+
+// decodeUnqualified decodes into v, whose fields name their elements without
+// prefix, an element nested in a qualified one
+func decodeUnqualified(d *xml.Decoder, start xml.StartElement, v interface{}) error {
+	return decodeRenamed(d, start, v, unqualified)
+}
+
+// decodeRenamed decodes the element start into v, its elements being renamed by rename
+func decodeRenamed(d *xml.Decoder, start xml.StartElement, v interface{}, rename func(xml.Name) xml.Name) error {
+	rd := xml.NewTokenDecoder(&renamingReader{d: d, start: &start, rename: rename})
+	tok, err := rd.Token()
+	if err != nil {
+		return err
+	}
+	renamedStart := tok.(xml.StartElement)
+	return rd.DecodeElement(v, &renamedStart)
+}
+
+// renamingReader returns the tokens of the element start, read from d
+type renamingReader struct {
+	d      *xml.Decoder
+	start  *xml.StartElement
+	depth  int
+	rename func(xml.Name) xml.Name
+}
+
+func (r *renamingReader) Token() (xml.Token, error) {
+	var tok xml.Token
+	if r.start != nil {
+		tok, r.start = *r.start, nil
+	} else if r.depth == 0 {
+		return nil, io.EOF
+	} else {
+		var err error
+		if tok, err = r.d.Token(); err != nil {
+			return nil, err
+		}
+	}
+	switch t := tok.(type) {
+	case xml.StartElement:
+		r.depth++
+		t.Name = r.rename(t.Name)
+		// The names are already resolved
+		t.Attr = WithoutNamespaceDeclarations(t.Attr)
+		return t, nil
+	case xml.EndElement:
+		r.depth--
+		t.Name = r.rename(t.Name)
+		return t, nil
+	}
+	return tok, nil
+}
+
+// WithoutNamespaceDeclarations returns the attributes but the xmlns ones, for
+// the tokens copied to an encoder which declares the namespaces of the names
+// it writes. The attributes are copied, the tokens of a decoder being shared
+func WithoutNamespaceDeclarations(attrs []xml.Attr) []xml.Attr {
+	kept := make([]xml.Attr, 0, len(attrs))
+	for _, attr := range attrs {
+		if attr.Name.Space == "xmlns" || (attr.Name.Space == "" && attr.Name.Local == "xmlns") {
+			continue
+		}
+		kept = append(kept, attr)
+	}
+	return kept
+}
+
+// unqualified restores a name renamed by decodeQualified
+func unqualified(name xml.Name) xml.Name {
+	if local, ok := strings.CutPrefix(name.Local, "onvif:"); ok {
+		return xml.Name{Space: schemaNamespace, Local: local}
+	}
+	return name
+}
+
+// UnmarshalXML decodes a VideoSourceConfiguration, whatever the prefixes used by the device
+func (c *VideoSourceConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain VideoSourceConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes an AudioSourceConfiguration, whatever the prefixes used by the device
+func (c *AudioSourceConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AudioSourceConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a VideoEncoder2Configuration, whatever the prefixes used by the device
+func (c *VideoEncoder2Configuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain VideoEncoder2Configuration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a VideoResolution, whatever the prefixes used by the device
+func (r *VideoResolution) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain VideoResolution
+	return decodeQualified(d, start, (*plain)(r))
+}
+
+// UnmarshalXML decodes a VideoResolution2, whatever the prefixes used by the device
+func (r *VideoResolution2) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain VideoResolution2
+	return decodeQualified(d, start, (*plain)(r))
+}
+
+// UnmarshalXML decodes an AudioEncoder2Configuration, whatever the prefixes used by the device
+func (c *AudioEncoder2Configuration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AudioEncoder2Configuration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a VideoAnalyticsConfiguration, whatever the prefixes used by the device
+func (c *VideoAnalyticsConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain VideoAnalyticsConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a MetadataConfiguration, whatever the prefixes used by the device
+func (c *MetadataConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain MetadataConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes an AudioOutputConfiguration, whatever the prefixes used by the device
+func (c *AudioOutputConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AudioOutputConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes an AudioDecoderConfiguration, whatever the prefixes used by the device
+func (c *AudioDecoderConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain AudioDecoderConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes an OSDConfiguration, whatever the prefixes used by the device
+func (c *OSDConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain OSDConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes an ImagingSettings20, whatever the prefixes used by the device
+func (s *ImagingSettings20) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain ImagingSettings20
+	return decodeQualified(d, start, (*plain)(s))
+}
+
+// UnmarshalXML decodes a PTZConfiguration, whatever the prefixes used by the device
+func (c *PTZConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain PTZConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes the PanTiltLimits of a PTZConfiguration, whose
+// descriptions of spaces name their elements without prefix
+func (l *PanTiltLimits) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain PanTiltLimits
+	return decodeUnqualified(d, start, (*plain)(l))
+}
+
+// UnmarshalXML decodes the ZoomLimits of a PTZConfiguration, as PanTiltLimits
+func (l *ZoomLimits) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain ZoomLimits
+	return decodeUnqualified(d, start, (*plain)(l))
+}
+
+// UnmarshalXML decodes a Polygon, whatever the prefixes used by the device
+func (p *Polygon) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain Polygon
+	return decodeQualified(d, start, (*plain)(p))
+}
+
+// UnmarshalXML decodes a RecordingConfiguration, whatever the prefixes used by the device
+func (c *RecordingConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain RecordingConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a TrackConfiguration, whatever the prefixes used by the device
+func (c *TrackConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain TrackConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a RecordingJobConfiguration, whatever the prefixes used by the device
+func (c *RecordingJobConfiguration) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain RecordingJobConfiguration
+	return decodeQualified(d, start, (*plain)(c))
+}
+
+// UnmarshalXML decodes a SourceReference, whatever the prefixes used by the device
+func (r *SourceReference) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type plain SourceReference
+	return decodeQualified(d, start, (*plain)(r))
+}
@@ -0,0 +1,50 @@
+package onvif
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// roundTrip marshals v as sent to a device, then decodes it into out as read
+// from a reply declaring the prefix onvif
+func roundTrip(t *testing.T, v, out interface{}) {
+	t.Helper()
+	data, err := xml.Marshal(v)
+	require.NoError(t, err)
+	reply := `<Reply xmlns:onvif="` + schemaNamespace + `">` + string(data) + `</Reply>`
+	require.NoError(t, xml.Unmarshal([]byte(reply), &struct {
+		Value interface{} `xml:",any"`
+	}{out}))
+}
+
+func TestRecordingConfigurationRoundTrip(t *testing.T) {
+	conf := RecordingConfiguration{
+		Source: RecordingSourceInformation{
+			SourceId:    "urn:camera",
+			Name:        "Camera",
+			Location:    Description{Description: "Entrance"},
+			Description: Description{Description: "Door"},
+		},
+		Content: Description{Description: "Front door"},
+	}
+	var got RecordingConfiguration
+	roundTrip(t, conf, &got)
+	assert.Equal(t, conf, got)
+
+	// The descriptions are the text of their elements
+	data, err := xml.Marshal(conf)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `<onvif:Content>Front door</onvif:Content>`)
+
+	const reply = `<tt:RecordingConfiguration xmlns:tt="http://www.onvif.org/ver10/schema">
+		<tt:Source><tt:SourceId>urn:camera</tt:SourceId><tt:Description>Door</tt:Description></tt:Source>
+		<tt:Content>Front door</tt:Content>
+	</tt:RecordingConfiguration>`
+	got = RecordingConfiguration{}
+	require.NoError(t, xml.Unmarshal([]byte(reply), &got))
+	assert.Equal(t, "Door", got.Source.Description.Description)
+	assert.Equal(t, "Front door", got.Content.Description)
+}